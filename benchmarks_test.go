@@ -125,3 +125,99 @@ func BenchmarkCombine(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkErrorsAccess(b *testing.B) {
+	err := appendN(nil, errors.New("err"), 100)
+
+	b.Run("Errors", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, e := range Errors(err) {
+				_ = e
+			}
+		}
+	})
+
+	b.Run("Len/At", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < Len(err); j++ {
+				_ = At(err, j)
+			}
+		}
+	})
+}
+
+func BenchmarkBuilder(b *testing.B) {
+	err := errors.New("err")
+
+	for _, n := range []int{10, 100, 10000} {
+		b.Run(fmt.Sprintf("Append/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var errs error
+				for j := 0; j < n; j++ {
+					errs = Append(errs, err)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("AppendInto/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var errs error
+				for j := 0; j < n; j++ {
+					AppendInto(&errs, err)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("Builder/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var bld Builder
+				for j := 0; j < n; j++ {
+					bld.Add(err)
+				}
+				_ = bld.Build()
+			}
+		})
+
+		b.Run(fmt.Sprintf("Builder/Grow/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var bld Builder
+				bld.Grow(n)
+				for j := 0; j < n; j++ {
+					bld.Add(err)
+				}
+				_ = bld.Build()
+			}
+		})
+	}
+}
+
+func BenchmarkError(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		err := appendN(nil, errors.New("err"), n)
+		errs := Errors(err)
+
+		b.Run(fmt.Sprintf("first/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				// Use a new multiError for each iteration so that
+				// the message is rendered from scratch.
+				_ = newMultiErr(errs...).Error()
+			}
+		})
+
+		b.Run(fmt.Sprintf("repeated/%d", n), func(b *testing.B) {
+			_ = err.Error()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = err.Error()
+			}
+		})
+	}
+}
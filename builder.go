@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+// Builder accumulates errors into a single buffer and combines them into an
+// error when [Builder.Build] is called. Use it instead of [Append] or
+// [AppendInto] when appending many errors in a loop.
+//
+//	var b multierr.Builder
+//	b.Grow(len(items))
+//	for _, item := range items {
+//		b.Add(process(item))
+//	}
+//	return b.Build()
+//
+// The zero value of Builder is ready to use. A Builder MUST NOT be copied
+// after first use, and is not safe for concurrent use.
+type Builder struct {
+	errors []error
+}
+
+// Grow grows the Builder's capacity, if necessary, to guarantee space for
+// another n errors without reallocating.
+func (b *Builder) Grow(n int) {
+	if n <= 0 || cap(b.errors)-len(b.errors) >= n {
+		return
+	}
+	errs := make([]error, len(b.errors), len(b.errors)+n)
+	copy(errs, b.errors)
+	b.errors = errs
+}
+
+// Add adds the given error to the Builder and reports whether it was
+// non-nil. nil errors are ignored, and multierr errors are flattened.
+//
+//	if b.Add(process(item)) {
+//		log.Warn("skipping item", item)
+//	}
+func (b *Builder) Add(err error) (errored bool) {
+	if err == nil {
+		return false
+	}
+	b.errors = appendFlattened(b.errors, err)
+	return true
+}
+
+// Build combines the errors added to the Builder so far into a single error.
+// It returns nil if no errors were added, and the error as-is if only a
+// single error was added.
+//
+// The returned error is not affected by further calls to Add.
+func (b *Builder) Build() error {
+	switch len(b.errors) {
+	case 0:
+		return nil
+	case 1:
+		return b.errors[0]
+	}
+
+	// Cap the buffer so that any further Adds reallocate instead of writing
+	// into the slice now owned by the returned error.
+	b.errors = b.errors[:len(b.errors):len(b.errors)]
+	return &multiError{errors: b.errors}
+}
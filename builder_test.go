@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder(t *testing.T) {
+	tests := []struct {
+		desc string
+		give []error
+		want error
+	}{
+		{desc: "empty"},
+		{
+			desc: "all nil",
+			give: []error{nil, nil},
+		},
+		{
+			desc: "single error",
+			give: []error{nil, errors.New("foo")},
+			want: errors.New("foo"),
+		},
+		{
+			desc: "multiple errors",
+			give: []error{errors.New("foo"), nil, errors.New("bar")},
+			want: newMultiErr(errors.New("foo"), errors.New("bar")),
+		},
+		{
+			desc: "flattens nested",
+			give: []error{
+				errors.New("foo"),
+				Combine(errors.New("bar"), errors.New("baz")),
+			},
+			want: newMultiErr(
+				errors.New("foo"),
+				errors.New("bar"),
+				errors.New("baz"),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var b Builder
+			b.Grow(len(tt.give))
+			for _, err := range tt.give {
+				assert.Equal(t, err != nil, b.Add(err))
+			}
+			got := b.Build()
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, Combine(tt.give...), got)
+		})
+	}
+}
+
+func TestBuilderGrow(t *testing.T) {
+	var b Builder
+	b.Add(errors.New("foo"))
+	b.Grow(10)
+	require.GreaterOrEqual(t, cap(b.errors), 11)
+
+	want := cap(b.errors)
+	for i := 0; i < 10; i++ {
+		b.Add(errors.New("bar"))
+	}
+	assert.Equal(t, want, cap(b.errors), "must not reallocate")
+	assert.Len(t, b.errors, 11)
+}
+
+func TestBuilderBuildIsImmutable(t *testing.T) {
+	var b Builder
+	b.Grow(10)
+	b.Add(errors.New("foo"))
+	b.Add(errors.New("bar"))
+
+	err := b.Build()
+	b.Add(errors.New("baz"))
+	assert.Equal(t, newMultiErr(errors.New("foo"), errors.New("bar")), err)
+
+	err2 := b.Build()
+	b.Add(errors.New("qux"))
+	assert.Equal(t, newMultiErr(errors.New("foo"), errors.New("bar")), err)
+	assert.Equal(t, newMultiErr(
+		errors.New("foo"),
+		errors.New("bar"),
+		errors.New("baz"),
+	), err2)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import "errors"
+
+// CodePolicy chooses a single code out of the codes reported by the errors
+// inside a combined error. codes holds at least one code, in the order of
+// the errors that reported them.
+//
+// See [FirstCode], [HighestCode] and [MostSevereStatus].
+type CodePolicy func(codes []int) int
+
+// FirstCode is a CodePolicy that chooses the code of the first error that
+// reported one.
+func FirstCode(codes []int) int {
+	return codes[0]
+}
+
+// HighestCode is a CodePolicy that chooses the highest code.
+func HighestCode(codes []int) int {
+	highest := codes[0]
+	for _, c := range codes[1:] {
+		if c > highest {
+			highest = c
+		}
+	}
+	return highest
+}
+
+// MostSevereStatus is a CodePolicy for HTTP status codes that prefers server
+// errors (5xx) over client errors (4xx), and client errors over all other
+// codes. It chooses the first code in the most severe class.
+func MostSevereStatus(codes []int) int {
+	best, bestRank := codes[0], statusRank(codes[0])
+	for _, c := range codes[1:] {
+		if r := statusRank(c); r > bestRank {
+			best, bestRank = c, r
+		}
+	}
+	return best
+}
+
+func statusRank(code int) int {
+	switch {
+	case code >= 500 && code < 600:
+		return 2
+	case code >= 400 && code < 500:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// WithCodePolicy specifies how [StatusCode] and [ExitCode] choose between
+// the codes of different errors. A nil policy is ignored.
+func WithCodePolicy(p CodePolicy) Option {
+	return optionFunc(func(o *options) {
+		if p != nil {
+			o.policy = p
+		}
+	})
+}
+
+// StatusCode returns the HTTP status code for the given error. It inspects
+// the errors inside it that implement the following method, or wrap an
+// error that does.
+//
+//	StatusCode() int
+//
+// If more than one error reports a status code, [MostSevereStatus] chooses
+// between them. Use [WithCodePolicy] to choose differently.
+//
+//	http.Error(w, err.Error(), multierr.StatusCode(err, http.StatusInternalServerError))
+//
+// fallback is returned if no error reports a status code. Errors built with
+// [Suppress] report only the status code of their primary error.
+func StatusCode(err error, fallback int, opts ...Option) int {
+	return chooseCode(err, fallback, statusCodeOf, MostSevereStatus, opts)
+}
+
+// ExitCode returns the process exit code for the given error. It inspects
+// the errors inside it that implement the following method, such as
+// *exec.ExitError, or wrap an error that does.
+//
+//	ExitCode() int
+//
+// If more than one error reports an exit code, [HighestCode] chooses between
+// them. Use [WithCodePolicy] to choose differently.
+//
+//	os.Exit(multierr.ExitCode(err, 1))
+//
+// fallback is returned if no error reports an exit code. Errors built with
+// [Suppress] report only the exit code of their primary error.
+func ExitCode(err error, fallback int, opts ...Option) int {
+	return chooseCode(err, fallback, exitCodeOf, HighestCode, opts)
+}
+
+func statusCodeOf(err error) (int, bool) {
+	if c, ok := err.(interface{ StatusCode() int }); ok {
+		return c.StatusCode(), true
+	}
+	return 0, false
+}
+
+func exitCodeOf(err error) (int, bool) {
+	if c, ok := err.(interface{ ExitCode() int }); ok {
+		return c.ExitCode(), true
+	}
+	return 0, false
+}
+
+func chooseCode(
+	err error,
+	fallback int,
+	codeOf func(error) (int, bool),
+	policy CodePolicy,
+	opts []Option,
+) int {
+	if p := newOptions(opts).policy; p != nil {
+		policy = p
+	}
+
+	codes := collectCodes(nil, err, codeOf)
+	if len(codes) == 0 {
+		return fallback
+	}
+	return policy(codes)
+}
+
+// collectCodes appends the codes reported by the tree of errors rooted at
+// err to codes.
+func collectCodes(codes []int, err error, codeOf func(error) (int, bool)) []int {
+	if err == nil {
+		return codes
+	}
+	if c, ok := codeOf(err); ok {
+		return append(codes, c)
+	}
+
+	if serr, ok := err.(*suppressedError); ok {
+		return collectCodes(codes, serr.errors[0], codeOf)
+	}
+
+	errs, ok := unwrapErrors(err)
+	if !ok {
+		return collectCodes(codes, errors.Unwrap(err), codeOf)
+	}
+	for _, e := range errs {
+		codes = collectCodes(codes, e, codeOf)
+	}
+	return codes
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type statusError int
+
+func (e statusError) Error() string   { return fmt.Sprintf("status %d", int(e)) }
+func (e statusError) StatusCode() int { return int(e) }
+
+type exitError int
+
+func (e exitError) Error() string { return fmt.Sprintf("exit %d", int(e)) }
+func (e exitError) ExitCode() int { return int(e) }
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		desc string
+		give error
+		opts []Option
+		want int
+	}{
+		{desc: "nil", want: 999},
+		{desc: "no codes", give: Combine(errors.New("foo"), errors.New("bar")), want: 999},
+		{desc: "single", give: statusError(404), want: 404},
+		{
+			desc: "wrapped",
+			give: fmt.Errorf("get user: %w", statusError(404)),
+			want: 404,
+		},
+		{
+			desc: "5xx over 4xx",
+			give: Combine(statusError(404), errors.New("foo"), statusError(503), statusError(500)),
+			want: 503,
+		},
+		{
+			desc: "4xx over others",
+			give: Combine(statusError(302), statusError(409), statusError(400)),
+			want: 409,
+		},
+		{
+			desc: "nested",
+			give: Combine(statusError(400), Group("upstream", statusError(502))),
+			want: 502,
+		},
+		{
+			desc: "first",
+			give: Combine(errors.New("foo"), statusError(404), statusError(503)),
+			opts: []Option{WithCodePolicy(FirstCode)},
+			want: 404,
+		},
+		{
+			desc: "highest",
+			give: Combine(statusError(404), statusError(503), statusError(599)),
+			opts: []Option{WithCodePolicy(HighestCode)},
+			want: 599,
+		},
+		{
+			desc: "custom policy",
+			give: Combine(statusError(404), statusError(503)),
+			opts: []Option{WithCodePolicy(func(codes []int) int {
+				return len(codes)
+			})},
+			want: 2,
+		},
+		{
+			desc: "nil policy",
+			give: Combine(statusError(404), statusError(503)),
+			opts: []Option{WithCodePolicy(nil)},
+			want: 503,
+		},
+		{
+			desc: "suppressed",
+			give: Suppress(statusError(404), statusError(500)),
+			want: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, StatusCode(tt.give, 999, tt.opts...))
+		})
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, 1, ExitCode(nil, 1))
+	assert.Equal(t, 1, ExitCode(errors.New("foo"), 1))
+	assert.Equal(t, 3, ExitCode(Combine(exitError(2), errors.New("foo"), exitError(3)), 1))
+	assert.Equal(t, 2, ExitCode(Combine(exitError(2), exitError(3)), 1, WithCodePolicy(FirstCode)))
+	assert.Equal(t, 3, ExitCode(Combine(exitError(2), exitError(3)), 1, WithCodePolicy(nil)))
+	assert.Equal(t, 1, ExitCode(statusError(500), 1), "status codes are not exit codes")
+}
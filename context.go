@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"context"
+	"sync"
+)
+
+type collectorKey struct{}
+
+// Collector accumulates errors reported with [Report] during an operation,
+// such as the handling of a request. It is safe for concurrent use.
+//
+// The zero value is an empty Collector ready to use.
+type Collector struct {
+	mu     sync.Mutex
+	errors []error
+}
+
+// NewContext returns a copy of ctx that carries a new Collector, along with
+// the Collector. Errors reported with [Report] on the returned context, or
+// on contexts derived from it, are added to the Collector.
+//
+//	func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//		ctx, errs := multierr.NewContext(r.Context())
+//		h.serve(w, r.WithContext(ctx))
+//		if err := errs.Err(); err != nil {
+//			h.log.Warn("request completed with errors", zap.Error(err))
+//		}
+//	}
+//
+// If ctx already carries a Collector, the new Collector takes its place for
+// the returned context; errors reported on it are not added to the outer
+// Collector.
+func NewContext(ctx context.Context) (context.Context, *Collector) {
+	c := new(Collector)
+	return context.WithValue(ctx, collectorKey{}, c), c
+}
+
+// Report adds a non-fatal error to the Collector carried by ctx, as set up
+// by [NewContext]. It does nothing if err is nil or if ctx does not carry a
+// Collector. Report may be called concurrently from multiple goroutines.
+//
+//	if err := h.cache.Set(ctx, key, value); err != nil {
+//		// Failing to cache the result should not fail the request.
+//		multierr.Report(ctx, err)
+//	}
+func Report(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+	if c, ok := ctx.Value(collectorKey{}).(*Collector); ok {
+		c.Report(err)
+	}
+}
+
+// Report adds an error to the Collector. nil errors are ignored.
+func (c *Collector) Report(err error) {
+	if err == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errors = appendFlattened(c.errors, err)
+}
+
+// Err returns the errors reported to the Collector so far, combined into a
+// single error with [Combine]. It returns nil if no errors were reported.
+//
+// Errors reported after Err returns are not part of the returned error.
+func (c *Collector) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Combine(c.errors...)
+}
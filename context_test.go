@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	ctx, errs := NewContext(context.Background())
+	assert.NoError(t, errs.Err())
+
+	err1 := errors.New("cache miss")
+	err2 := errors.New("stale replica")
+	err3 := errors.New("slow query")
+
+	Report(ctx, err1)
+	Report(ctx, nil)
+
+	// Contexts derived from ctx report to the same Collector.
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+	Report(child, Combine(err2, err3))
+
+	err := errs.Err()
+	assert.Equal(t, []error{err1, err2, err3}, Errors(err),
+		"reported errors must be flattened")
+
+	Report(ctx, errors.New("late"))
+	assert.Len(t, Errors(err), 3, "Err must not change after it returns")
+	assert.Len(t, Errors(errs.Err()), 4)
+}
+
+func TestReportWithoutCollector(t *testing.T) {
+	assert.NotPanics(t, func() {
+		Report(context.Background(), errors.New("great sadness"))
+	})
+}
+
+func TestReportSingleError(t *testing.T) {
+	ctx, errs := NewContext(context.Background())
+	err := errors.New("great sadness")
+	Report(ctx, err)
+	assert.Same(t, err, errs.Err())
+}
+
+func TestNewContextNested(t *testing.T) {
+	ctx, outer := NewContext(context.Background())
+	Report(ctx, errors.New("outer"))
+
+	inner, errs := NewContext(ctx)
+	Report(inner, errors.New("inner"))
+
+	assert.EqualError(t, outer.Err(), "outer")
+	assert.EqualError(t, errs.Err(), "inner")
+}
+
+func TestCollectorZeroValue(t *testing.T) {
+	var c Collector
+	assert.NoError(t, c.Err())
+
+	c.Report(nil)
+	assert.NoError(t, c.Err())
+
+	c.Report(errors.New("great sadness"))
+	assert.EqualError(t, c.Err(), "great sadness")
+}
+
+func TestReportConcurrent(t *testing.T) {
+	const goroutines = 10
+	const reports = 100
+
+	ctx, errs := NewContext(context.Background())
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < reports; j++ {
+				Report(ctx, fmt.Errorf("goroutine %d: report %d", i, j))
+				_ = errs.Err()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	err := errs.Err()
+	require.Error(t, err)
+	assert.Equal(t, goroutines*reports, Len(err))
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IgnoreOrder makes [Equal] consider errors equal even if the errors they
+// are composed of are in a different order.
+func IgnoreOrder() Option {
+	return optionFunc(func(o *options) {
+		o.ignoreOrder = true
+	})
+}
+
+// Equal reports whether the given errors are composed of the same errors in
+// the same order. Errors are matched as described in [Diff]. Pass
+// [IgnoreOrder] to accept errors in any order.
+//
+//	multierr.Equal(want, got, multierr.IgnoreOrder())
+func Equal(a, b error, opts ...Option) bool {
+	o := newOptions(opts)
+
+	d := alignErrors(viewErrors(a), viewErrors(b))
+	return len(d.missing) == 0 && len(d.extra) == 0 && len(d.changed) == 0 &&
+		(o.ignoreOrder || len(d.moved) == 0)
+}
+
+// Diff compares the errors that the given errors are composed of, and
+// returns a human-readable report of the differences between them. It
+// returns an empty string if there are no differences.
+//
+//	if d := multierr.Diff(want, got); d != "" {
+//		t.Errorf("unexpected errors (-want +got):\n%s", d)
+//	}
+//
+// Errors are aligned with each other by errors.Is first, and by their
+// messages otherwise. The report lists one difference per line, for example:
+//
+//	missing want[1]: connection refused
+//	extra got[2]: invalid argument
+//	changed want[0] got[0]: "open foo: not found" != "open bar: not found"
+//	moved want[3] got[4]: context canceled
+//
+// Errors are reported as changed if they match with errors.Is but their
+// messages differ, and as moved if they were found out of order.
+func Diff(want, got error) string {
+	wants, gots := viewErrors(want), viewErrors(got)
+	d := alignErrors(wants, gots)
+	if len(d.missing)+len(d.extra)+len(d.changed)+len(d.moved) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, i := range d.missing {
+		fmt.Fprintf(&sb, "missing want[%d]: %v\n", i, wants[i])
+	}
+	for _, j := range d.extra {
+		fmt.Fprintf(&sb, "extra got[%d]: %v\n", j, gots[j])
+	}
+	for _, p := range d.changed {
+		fmt.Fprintf(&sb, "changed want[%d] got[%d]: %q != %q\n", p.want, p.got,
+			wants[p.want].Error(), gots[p.got].Error())
+	}
+	for _, p := range d.moved {
+		fmt.Fprintf(&sb, "moved want[%d] got[%d]: %v\n", p.want, p.got, gots[p.got])
+	}
+	return sb.String()
+}
+
+type errorPair struct{ want, got int }
+
+type errorDiff struct {
+	missing []int       // indexes into want
+	extra   []int       // indexes into got
+	changed []errorPair // matched with errors.Is, but messages differ
+	moved   []errorPair // matched, but out of order
+}
+
+// alignErrors matches the errors in want with the errors in got.
+func alignErrors(want, got []error) (d errorDiff) {
+	matches := make([]int, len(want)) // want index => got index or -1
+	for i := range matches {
+		matches[i] = -1
+	}
+	used := make([]bool, len(got))
+
+	match := func(eq func(w, g error) bool) {
+		for i, j := range matches {
+			if j >= 0 {
+				continue
+			}
+			w := want[i]
+			for j := range used {
+				if !used[j] && eq(w, got[j]) {
+					matches[i] = j
+					used[j] = true
+					break
+				}
+			}
+		}
+	}
+	match(func(w, g error) bool { return errors.Is(g, w) })
+	match(func(w, g error) bool { return w.Error() == g.Error() })
+
+	for j, u := range used {
+		if !u {
+			d.extra = append(d.extra, j)
+		}
+	}
+
+	inOrder := longestIncreasing(matches)
+	for i, j := range matches {
+		if j < 0 {
+			d.missing = append(d.missing, i)
+			continue
+		}
+
+		p := errorPair{want: i, got: j}
+		if want[i].Error() != got[j].Error() {
+			d.changed = append(d.changed, p)
+		}
+		if !inOrder[i] {
+			d.moved = append(d.moved, p)
+		}
+	}
+	return d
+}
+
+// longestIncreasing finds the longest strictly increasing subsequence of the
+// non-negative values in xs, and reports which indexes of xs are part of it.
+// Values that are not part of it are the smallest set of values that must be
+// moved to put xs in order.
+func longestIncreasing(xs []int) []bool {
+	var (
+		tails = make([]int, 0, len(xs)) // index in xs of the tail of each run
+		prev  = make([]int, len(xs))    // index in xs of the previous value
+	)
+	for i, x := range xs {
+		prev[i] = -1
+		if x < 0 {
+			continue
+		}
+
+		// Find the first run whose tail is not smaller than x.
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if xs[tails[mid]] < x {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+
+		if lo > 0 {
+			prev[i] = tails[lo-1]
+		}
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+
+	in := make([]bool, len(xs))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			in[i] = true
+		}
+	}
+	return in
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		desc             string
+		want             error
+		got              error
+		wantDiff         string
+		wantEqual        bool
+		wantEqualUnorder bool
+	}{
+		{
+			desc:             "nil",
+			wantEqual:        true,
+			wantEqualUnorder: true,
+		},
+		{
+			desc:             "same",
+			want:             Combine(io.EOF, errors.New("foo")),
+			got:              Combine(io.EOF, errors.New("foo")),
+			wantEqual:        true,
+			wantEqualUnorder: true,
+		},
+		{
+			desc:             "single error",
+			want:             errors.New("foo"),
+			got:              errors.New("foo"),
+			wantEqual:        true,
+			wantEqualUnorder: true,
+		},
+		{
+			desc:     "missing",
+			want:     Combine(io.EOF, errors.New("foo"), errors.New("bar")),
+			got:      Combine(io.EOF, errors.New("bar")),
+			wantDiff: "missing want[1]: foo\n",
+		},
+		{
+			desc:     "extra",
+			want:     errors.New("foo"),
+			got:      Combine(errors.New("foo"), errors.New("bar")),
+			wantDiff: "extra got[1]: bar\n",
+		},
+		{
+			desc:     "missing from nil",
+			want:     errors.New("foo"),
+			wantDiff: "missing want[0]: foo\n",
+		},
+		{
+			desc: "changed",
+			want: Combine(os.ErrNotExist, errors.New("foo")),
+			got: Combine(
+				fmt.Errorf("open bar: %w", os.ErrNotExist),
+				errors.New("foo"),
+			),
+			wantDiff: `changed want[0] got[0]: "file does not exist" != "open bar: file does not exist"` + "\n",
+		},
+		{
+			desc:             "moved",
+			want:             Combine(errors.New("a"), errors.New("b"), errors.New("c"), errors.New("d")),
+			got:              Combine(errors.New("b"), errors.New("c"), errors.New("a"), errors.New("d")),
+			wantDiff:         "moved want[0] got[2]: a\n",
+			wantEqualUnorder: true,
+		},
+		{
+			desc: "all",
+			want: Combine(
+				errors.New("a"),
+				os.ErrNotExist,
+				errors.New("b"),
+				errors.New("c"),
+			),
+			got: Combine(
+				errors.New("c"),
+				errors.New("b"),
+				fmt.Errorf("stat: %w", os.ErrNotExist),
+				errors.New("d"),
+			),
+			wantDiff: "missing want[0]: a\n" +
+				"extra got[3]: d\n" +
+				`changed want[1] got[2]: "file does not exist" != "stat: file does not exist"` + "\n" +
+				"moved want[1] got[2]: stat: file does not exist\n" +
+				"moved want[2] got[1]: b\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.wantDiff, Diff(tt.want, tt.got))
+			assert.Equal(t, tt.wantEqual, Equal(tt.want, tt.got), "Equal")
+			assert.Equal(t, tt.wantEqualUnorder, Equal(tt.want, tt.got, IgnoreOrder()), "Equal/IgnoreOrder")
+		})
+	}
+}
+
+func TestLongestIncreasing(t *testing.T) {
+	tests := []struct {
+		give []int
+		want []bool
+	}{
+		{give: nil, want: []bool{}},
+		{give: []int{0, 1, 2}, want: []bool{true, true, true}},
+		{give: []int{2, 0, 1}, want: []bool{false, true, true}},
+		{give: []int{1, 2, 0}, want: []bool{true, true, false}},
+		{give: []int{0, -1, 1}, want: []bool{true, false, true}},
+		{give: []int{3, 0, 2, 1, 4}, want: []bool{false, true, false, true, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.give), func(t *testing.T) {
+			assert.Equal(t, tt.want, longestIncreasing(tt.give))
+		})
+	}
+}
@@ -143,6 +143,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -169,6 +171,17 @@ var (
 	// 	    bar
 	_multilineSeparator = []byte("\n -  ")
 	_multilineIndent    = []byte("    ")
+
+	// Indentation of items in numbered multi-line messages. Nested lists of
+	// errors are indented further by _multilineIndent.
+	//
+	// For example,
+	//
+	// 	 [1/2] foo
+	// 	 [2/2]
+	// 	     [2.1/2] bar
+	// 	     [2.2/2] baz
+	_numberedIndent = " "
 )
 
 // _bufferPool is a pool of bytes.Buffers.
@@ -201,21 +214,80 @@ type multipleErrors interface {
 // If the error is not composed of other errors, the returned slice contains
 // just the error that was passed in.
 //
+// Errors recognizes the Go 1.20 multi-error interface, older multi-error
+// shapes, and any shapes added with [RegisterExtractor].
+//
 // Callers of this function are free to modify the returned slice.
 func Errors(err error) []error {
 	return extractErrors(err)
 }
 
+// Len returns the number of errors that the supplied error is composed of
+// without allocating.
+//
+//	for i := 0; i < multierr.Len(err); i++ {
+//		inspect(multierr.At(err, i))
+//	}
+//
+// Len returns 0 if the error is nil, and 1 if the error is not composed of
+// other errors.
+//
+// Len and At take constant time, except for errors whose underlying type is
+// a slice of pointers or interfaces, such as go/scanner.ErrorList. Nil
+// elements of these slices are skipped, so Len and At walk the slice on
+// every call, and a loop over them takes quadratic time. Use [Errors] to
+// iterate over such errors instead.
+func Len(err error) int {
+	errs, slice, ok := unwrapList(err)
+	switch {
+	case slice.IsValid():
+		return sliceLen(slice)
+	case ok:
+		return len(errs)
+	case err == nil:
+		return 0
+	}
+	return 1
+}
+
+// At returns the error at index i in the list of errors that the supplied
+// error is composed of without allocating. It is the read-only, indexed
+// counterpart of [Errors].
+//
+// If the error is not composed of other errors, the error itself is at index
+// 0. At panics if i is out of the range [0, Len(err)).
+//
+// See [Len] for the cost of At.
+func At(err error, i int) error {
+	errs, slice, ok := unwrapList(err)
+	switch {
+	case slice.IsValid():
+		if item := sliceAt(slice, i); item != nil {
+			return item
+		}
+	case ok:
+		return errs[i]
+	case err != nil && i == 0:
+		return err
+	}
+	panic(fmt.Sprintf("multierr.At: index %d out of range [0:%d]", i, Len(err)))
+}
+
 // multiError is an error that holds one or more errors.
 //
 // An instance of this is guaranteed to be non-empty and flattened. That is,
 // none of the errors inside multiError are other multiErrors.
 //
 // multiError formats to a semi-colon delimited list of error messages with
-// %v and with a more readable multi-line format with %+v.
+// %v, with a more readable multi-line format with %+v, and with a numbered
+// multi-line format with %#v.
 type multiError struct {
 	copyNeeded atomic.Bool
 	errors     []error
+
+	// Single-line message rendered by the first call to Error.
+	// multiError is immutable so this never needs to be invalidated.
+	message atomic.Pointer[string]
 }
 
 // Unwrap returns a list of errors wrapped by this multierr.
@@ -240,25 +312,35 @@ func (merr *multiError) Error() string {
 		return ""
 	}
 
+	if msg := merr.message.Load(); msg != nil {
+		return *msg
+	}
+
 	buff := _bufferPool.Get().(*bytes.Buffer)
 	buff.Reset()
 
-	merr.writeSingleline(buff)
+	merr.writeSingleline(buff, formatOptions{})
 
 	result := buff.String()
 	_bufferPool.Put(buff)
+
+	// Concurrent callers may race to render the message, but they will all
+	// arrive at the same result so it doesn't matter who wins.
+	merr.message.Store(&result)
 	return result
 }
 
 func (merr *multiError) Format(f fmt.State, c rune) {
-	if c == 'v' && f.Flag('+') {
-		merr.writeMultiline(f)
+	if c == 'v' && f.Flag('#') {
+		merr.writeMultiline(f, formatOptions{multiline: true, numbered: true})
+	} else if c == 'v' && f.Flag('+') {
+		merr.writeMultiline(f, formatOptions{multiline: true})
 	} else {
-		merr.writeSingleline(f)
+		io.WriteString(f, merr.Error())
 	}
 }
 
-func (merr *multiError) writeSingleline(w io.Writer) {
+func (merr *multiError) writeSingleline(w io.Writer, opts formatOptions) {
 	first := true
 	for _, item := range merr.errors {
 		if first {
@@ -266,15 +348,64 @@ func (merr *multiError) writeSingleline(w io.Writer) {
 		} else {
 			w.Write(_singlelineSeparator)
 		}
-		io.WriteString(w, item.Error())
+		io.WriteString(w, opts.truncateItem(item.Error()))
 	}
 }
 
-func (merr *multiError) writeMultiline(w io.Writer) {
+func (merr *multiError) writeMultiline(w io.Writer, opts formatOptions) {
 	w.Write(_multilinePrefix)
+	if opts.numbered {
+		writeNumbered(w, merr.errors, "", _numberedIndent, opts)
+		return
+	}
 	for _, item := range merr.errors {
 		w.Write(_multilineSeparator)
-		writePrefixLine(w, _multilineIndent, fmt.Sprintf("%+v", item))
+		writePrefixLine(w, _multilineIndent, opts.truncateItem(fmt.Sprintf("%+v", item)))
+	}
+}
+
+// writeNumbered writes each of the given errors on its own line, prefixed
+// with its position in the list. Groups are expanded in place, with their
+// positions qualified by the path to them, and suppressed errors are listed
+// below their primary error. Other errors are rendered with %+v so that
+// their own message and formatting are preserved.
+func writeNumbered(w io.Writer, errs []error, path, indent string, opts formatOptions) {
+	total := "/" + strconv.Itoa(len(errs)) + "]"
+	for i, item := range errs {
+		label := path + strconv.Itoa(i+1)
+
+		io.WriteString(w, "\n"+indent+"["+label+total)
+		// multiErrors are always flattened, so they can't be nested.
+		cont := indent + string(_multilineIndent)
+		switch nested := item.(type) {
+		case *groupError:
+			io.WriteString(w, " "+nested.label+":")
+			writeNumbered(w, nested.errors, label+".", cont, opts)
+			continue
+		case *suppressedError:
+			io.WriteString(w, " ")
+			writePrefixLine(w, []byte(cont), opts.truncateItem(fmt.Sprintf("%+v", nested.errors[0])))
+			writeSuppressed(w, nested.errors[1:], cont, opts)
+			continue
+		}
+
+		io.WriteString(w, " ")
+		writePrefixLine(w, []byte(cont), opts.truncateItem(fmt.Sprintf("%+v", item)))
+	}
+}
+
+// writeSuppressed lists suppressed errors below their primary error in a
+// numbered multi-line message, aligned with the given indentation.
+//
+//	[2/2] request failed
+//	    the following errors were suppressed:
+//	    -  close failed
+func writeSuppressed(w io.Writer, errs []error, indent string, opts formatOptions) {
+	io.WriteString(w, "\n"+indent)
+	w.Write(_suppressedPrefix[1:])
+	for _, item := range errs {
+		io.WriteString(w, "\n"+indent+"-  ")
+		writePrefixLine(w, []byte(indent+"   "), opts.truncateItem(fmt.Sprintf("%+v", item)))
 	}
 }
 
@@ -369,20 +500,25 @@ func fromSlice(errors []error) error {
 
 	nonNilErrs := make([]error, 0, res.Capacity)
 	for _, err := range errors[res.FirstErrorIdx:] {
-		if err == nil {
-			continue
-		}
-
-		if nested, ok := err.(*multiError); ok {
-			nonNilErrs = append(nonNilErrs, nested.errors...)
-		} else {
-			nonNilErrs = append(nonNilErrs, err)
-		}
+		nonNilErrs = appendFlattened(nonNilErrs, err)
 	}
 
 	return &multiError{errors: nonNilErrs}
 }
 
+// appendFlattened appends err to the given list of errors, expanding it in
+// place if it's a multiError. nil errors are skipped.
+func appendFlattened(errs []error, err error) []error {
+	if err == nil {
+		return errs
+	}
+
+	if nested, ok := err.(*multiError); ok {
+		return append(errs, nested.errors...)
+	}
+	return append(errs, err)
+}
+
 // Combine combines the passed errors into a single error.
 //
 // If zero arguments were passed or if all items are nil, a nil error is
@@ -530,12 +666,64 @@ func extractErrors(err error) []error {
 
 	// check if the given err is an Unwrapable error that
 	// implements multipleErrors interface.
-	eg, ok := err.(multipleErrors)
+	errs, ok := unwrapErrors(err)
 	if !ok {
 		return []error{err}
 	}
 
-	return append(([]error)(nil), eg.Unwrap()...)
+	return append(([]error)(nil), errs...)
+}
+
+// unwrapErrors returns the list of errors that the given error is composed
+// of, copying it only if err is a slice of errors. ok is false if err is not
+// composed of other errors, or if its list of errors is empty.
+//
+// The returned slice MUST NOT be modified.
+func unwrapErrors(err error) (errs []error, ok bool) {
+	errs, slice, ok := unwrapList(err)
+	if slice.IsValid() {
+		errs = sliceErrors(slice)
+	}
+	return errs, ok
+}
+
+// viewErrors is similar to Errors, but it avoids copying the list of errors
+// where possible.
+//
+// The returned slice MUST NOT be modified.
+func viewErrors(err error) []error {
+	if errs, ok := unwrapErrors(err); ok {
+		return errs
+	}
+	if err == nil {
+		return nil
+	}
+	return []error{err}
+}
+
+// unwrapList is similar to unwrapErrors, but it never copies. If err is a
+// slice of errors, it's returned as slice instead of errs.
+func unwrapList(err error) (errs []error, slice reflect.Value, ok bool) {
+	switch err := err.(type) {
+	case *multiError:
+		return err.Errors(), reflect.Value{}, true
+	case *suppressedError:
+		// Suppressed errors are not peers of the primary error.
+		return unwrapList(err.errors[0])
+	}
+	if err == nil {
+		return nil, reflect.Value{}, false
+	}
+
+	if eg, ok := err.(multipleErrors); ok {
+		errs = eg.Unwrap()
+	} else if errs, ok = extractOther(err); !ok {
+		slice, ok = errorSlice(err)
+		return nil, slice, ok
+	}
+
+	// An error with an empty list of errors is a plain error.
+	return errs, reflect.Value{}, len(errs) > 0
 }
 
 // Invoker is an operation that may fail with an error. Use it with
@@ -599,6 +787,90 @@ func Close(closer io.Closer) Invoker {
 	return Invoke(closer.Close)
 }
 
+// Named builds an Invoker that labels failures of the provided Invoker with
+// the given operation name. Use it to tell apart deferred operations whose
+// errors would otherwise be indistinguishable.
+//
+//	defer multierr.AppendInvoke(&err, multierr.Named("flush audit log", multierr.Invoke(log.Flush)))
+//
+// If the Invoker fails, the returned error reads "flush audit log: <err>".
+// The original error remains reachable with errors.Is and errors.As, and the
+// label may be retrieved with [Op].
+func Named(name string, invoker Invoker) Invoker {
+	return namedInvoker{name: name, invoker: invoker}
+}
+
+// CloseNamed builds an Invoker that closes the provided io.Closer and labels
+// its failure with the given operation name.
+//
+//	defer multierr.AppendInvoke(&err, multierr.CloseNamed("close upstream", conn))
+//
+// This is shorthand for,
+//
+//	multierr.Named(name, multierr.Close(closer))
+func CloseNamed(name string, closer io.Closer) Invoker {
+	return Named(name, Close(closer))
+}
+
+// withOp labels an error with the given operation name. It returns nil if
+// err is nil.
+func withOp(name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &namedError{op: name, err: err}
+}
+
+type namedInvoker struct {
+	name    string
+	invoker Invoker
+}
+
+func (n namedInvoker) Invoke() error {
+	return withOp(n.name, n.invoker.Invoke())
+}
+
+// namedError is an error labeled with the name of the operation that
+// produced it.
+type namedError struct {
+	op  string
+	err error
+}
+
+func (e *namedError) Error() string {
+	return e.op + ": " + e.err.Error()
+}
+
+// Unwrap returns the error that was labeled.
+func (e *namedError) Unwrap() error {
+	return e.err
+}
+
+func (e *namedError) Format(f fmt.State, c rune) {
+	io.WriteString(f, e.op)
+	io.WriteString(f, ": ")
+	if c == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, "%+v", e.err)
+	} else {
+		io.WriteString(f, e.err.Error())
+	}
+}
+
+// Op reports the operation name attached to err by [Named] or [CloseNamed].
+// If err is a combined error, the name of the first labeled error found
+// inside it is returned.
+//
+//	multierr.Op(err) // == "flush audit log"
+//
+// Op returns an empty string if no operation name was attached to err.
+func Op(err error) string {
+	var nerr *namedError
+	if errors.As(err, &nerr) {
+		return nerr.op
+	}
+	return ""
+}
+
 // AppendInvoke appends the result of calling the given Invoker into the
 // provided error pointer. Use it with named returns to safely defer
 // invocation of fallible operations until a function returns, and capture the
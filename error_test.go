@@ -21,6 +21,7 @@
 package multierr
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -328,6 +329,128 @@ func TestCombine(t *testing.T) {
 	}
 }
 
+func TestNumberedFormat(t *testing.T) {
+	tests := []struct {
+		desc string
+		give error
+		want string
+	}{
+		{
+			desc: "flat",
+			give: Combine(
+				errors.New("foo"),
+				errors.New("bar"),
+				errors.New("baz"),
+			),
+			want: "the following errors occurred:\n" +
+				" [1/3] foo\n" +
+				" [2/3] bar\n" +
+				" [3/3] baz",
+		},
+		{
+			desc: "multiline item",
+			give: Combine(
+				errors.New("foo"),
+				richFormatError{},
+			),
+			want: "the following errors occurred:\n" +
+				" [1/2] foo\n" +
+				" [2/2] multiline\n" +
+				"     message\n" +
+				"     with plus",
+		},
+		{
+			desc: "nested",
+			give: Combine(
+				errors.New("foo"),
+				Group("cleanup",
+					errors.New("bar"),
+					Group("cache", errors.New("baz"), errors.New("qux")),
+				),
+			),
+			want: "the following errors occurred:\n" +
+				" [1/2] foo\n" +
+				" [2/2] cleanup:\n" +
+				"     [2.1/2] bar\n" +
+				"     [2.2/2] cache:\n" +
+				"         [2.2.1/2] baz\n" +
+				"         [2.2.2/2] qux",
+		},
+		{
+			desc: "wrapped errors keep their message",
+			give: Combine(
+				errors.New("foo"),
+				fmt.Errorf("open config: %w, %w", errors.New("bar"), errors.New("baz")),
+				errors.Join(errors.New("qux"), errors.New("quux")),
+			),
+			want: "the following errors occurred:\n" +
+				" [1/3] foo\n" +
+				" [2/3] open config: bar, baz\n" +
+				" [3/3] qux\n" +
+				"     quux",
+		},
+		{
+			desc: "suppressed",
+			give: Combine(
+				errors.New("foo"),
+				Suppress(errors.New("bar"), richFormatError{}, errors.New("baz")),
+			),
+			want: "the following errors occurred:\n" +
+				" [1/2] foo\n" +
+				" [2/2] bar\n" +
+				"     the following errors were suppressed:\n" +
+				"     -  multiline\n" +
+				"        message\n" +
+				"        with plus\n" +
+				"     -  baz",
+		},
+		{
+			desc: "suppressed in group",
+			give: Combine(
+				errors.New("foo"),
+				Group("cleanup", Suppress(errors.New("bar"), errors.New("baz"))),
+			),
+			want: "the following errors occurred:\n" +
+				" [1/2] foo\n" +
+				" [2/2] cleanup:\n" +
+				"     [2.1/1] bar\n" +
+				"         the following errors were suppressed:\n" +
+				"         -  baz",
+		},
+		{
+			desc: "formatted item keeps its limits",
+			give: Combine(
+				errors.New("foo"),
+				WithFormat(errors.New("long long long"), MaxBytes(4)),
+			),
+			want: "the following errors occurred:\n" +
+				" [1/2] foo\n" +
+				" [2/2] long... (10 bytes elided)",
+		},
+		{
+			desc: "keyed",
+			give: Combine(
+				keyed(3, errors.New("foo")),
+				keyed(7, errors.New("bar")),
+			),
+			want: "the following errors occurred:\n" +
+				" [1/2] [3]: foo\n" +
+				" [2/2] [7]: bar",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, fmt.Sprintf("%#v", tt.give))
+
+			var buff bytes.Buffer
+			_, err := WriteTo(&buff, tt.give, Numbered())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, buff.String())
+		})
+	}
+}
+
 func TestCombineDoesNotModifySlice(t *testing.T) {
 	errors := []error{
 		errors.New("foo"),
@@ -447,10 +570,10 @@ func TestErrors(t *testing.T) {
 			dontCast: true,
 		},
 		{
-			// We don't yet support non-multierr errors that do
-			// not implement Unwrap() []error.
+			// Non-multierr errors that implement the older
+			// errorGroup interface.
 			give:     notMultiErr{},
-			want:     []error{notMultiErr{}},
+			want:     []error{errors.New("great sadness")},
 			dontCast: true,
 		},
 		{
@@ -530,6 +653,56 @@ func createMultiErrWithCapacity() error {
 	return appendN(nil, errors.New("append"), 50)
 }
 
+func TestLenAt(t *testing.T) {
+	tests := []struct {
+		desc string
+		give error
+		want []error
+	}{
+		{desc: "nil"},
+		{
+			desc: "single error",
+			give: errors.New("foo"),
+			want: []error{errors.New("foo")},
+		},
+		{
+			desc: "multiError",
+			give: Combine(errors.New("foo"), errors.New("bar")),
+			want: []error{errors.New("foo"), errors.New("bar")},
+		},
+		{
+			desc: "errors.Join",
+			give: errors.Join(errors.New("foo"), errors.New("bar")),
+			want: []error{errors.New("foo"), errors.New("bar")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			require.Equal(t, len(tt.want), Len(tt.give))
+			for i, want := range tt.want {
+				assert.Equal(t, want, At(tt.give, i))
+			}
+			assert.Panics(t, func() {
+				At(tt.give, len(tt.want))
+			})
+			assert.Panics(t, func() {
+				At(tt.give, -1)
+			})
+		})
+	}
+}
+
+func TestLenAtNoAlloc(t *testing.T) {
+	err := Combine(errors.New("foo"), errors.New("bar"), errors.New("baz"))
+	allocs := testing.AllocsPerRun(100, func() {
+		for i := 0; i < Len(err); i++ {
+			_ = At(err, i)
+		}
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
 func TestAppendDoesNotModify(t *testing.T) {
 	initial := createMultiErrWithCapacity()
 	err1 := Append(initial, errors.New("err1"))
@@ -586,6 +759,33 @@ func TestNilMultierror(t *testing.T) {
 	require.Empty(t, err.Errors())
 }
 
+func TestErrorMessageCached(t *testing.T) {
+	err := Combine(errors.New("foo"), errors.New("bar"), errors.New("baz"))
+	want := "foo; bar; baz"
+
+	require.Equal(t, want, err.Error())
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = err.Error()
+	})
+	assert.Equal(t, 0.0, allocs, "Error() must not allocate after the first call")
+	assert.Equal(t, want, err.Error())
+	assert.Equal(t, want, fmt.Sprintf("%v", err))
+}
+
+func TestErrorMessageRace(t *testing.T) {
+	err := Combine(errors.New("foo"), errors.New("bar"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, "foo; bar", err.Error())
+		}()
+	}
+	wg.Wait()
+}
+
 func TestAppendInto(t *testing.T) {
 	tests := []struct {
 		desc string
@@ -715,6 +915,92 @@ func TestClose(t *testing.T) {
 	})
 }
 
+func TestNamed(t *testing.T) {
+	t.Run("fail", func(t *testing.T) {
+		give := errors.New("great sadness")
+		got := Named("flush audit log", Invoke(func() error {
+			return give
+		})).Invoke()
+		require.Error(t, got)
+		assert.Equal(t, "flush audit log: great sadness", got.Error())
+		assert.Equal(t, "flush audit log: great sadness", fmt.Sprintf("%v", got))
+		assert.ErrorIs(t, got, give)
+		assert.Equal(t, "flush audit log", Op(got))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		got := Named("flush audit log", Invoke(func() error {
+			return nil
+		})).Invoke()
+		assert.Nil(t, got)
+	})
+
+	t.Run("rich format", func(t *testing.T) {
+		got := Named("render", Invoke(func() error {
+			return richFormatError{}
+		})).Invoke()
+		assert.Equal(t, "render: without plus", fmt.Sprintf("%v", got))
+		assert.Equal(t, "render: multiline\nmessage\nwith plus", fmt.Sprintf("%+v", got))
+
+		var rich richFormatError
+		assert.ErrorAs(t, got, &rich)
+	})
+}
+
+func TestCloseNamed(t *testing.T) {
+	var err error
+	AppendInvoke(&err, CloseNamed("close reader", newCloserMock(t, errors.New("broken pipe"))))
+	AppendInvoke(&err, CloseNamed("close writer", newCloserMock(t, nil)))
+	AppendInvoke(&err, CloseNamed("close conn", newCloserMock(t, errors.New("invalid argument"))))
+
+	require.Error(t, err)
+	assert.Equal(t, "close reader: broken pipe; close conn: invalid argument", err.Error())
+
+	errs := Errors(err)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "close reader", Op(errs[0]))
+	assert.Equal(t, "close conn", Op(errs[1]))
+}
+
+func TestOp(t *testing.T) {
+	tests := []struct {
+		desc string
+		give error
+		want string
+	}{
+		{desc: "nil"},
+		{
+			desc: "unnamed",
+			give: errors.New("great sadness"),
+		},
+		{
+			desc: "named",
+			give: &namedError{op: "foo", err: errors.New("great sadness")},
+			want: "foo",
+		},
+		{
+			desc: "wrapped",
+			give: fmt.Errorf("wrapped: %w", &namedError{op: "foo", err: errors.New("great sadness")}),
+			want: "foo",
+		},
+		{
+			desc: "combined",
+			give: Combine(
+				errors.New("great sadness"),
+				&namedError{op: "bar", err: errors.New("unprecedented failure")},
+				&namedError{op: "baz", err: errors.New("root cause")},
+			),
+			want: "bar",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, Op(tt.give))
+		})
+	}
+}
+
 func TestAppendIntoNil(t *testing.T) {
 	t.Run("nil pointer panics", func(t *testing.T) {
 		assert.Panics(t, func() {
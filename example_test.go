@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 
 	"go.uber.org/multierr"
 )
@@ -72,6 +73,20 @@ func ExampleErrors() {
 	// call 5 failed
 }
 
+func ExampleWriteTo() {
+	err := multierr.Combine(
+		errors.New("call 1 failed"),
+		errors.New("call 2 failed"),
+		errors.New("call 3 failed"),
+	)
+
+	multierr.WriteTo(os.Stdout, err, multierr.Multiline(), multierr.MaxBytes(64))
+	// Output:
+	// the following errors occurred:
+	//  -  call 1 failed
+	//  -  call 2 fail... (20 bytes elided)
+}
+
 func ExampleAppendInto() {
 	var err error
 
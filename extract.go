@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// Extractor retrieves the list of errors that an error is composed of.
+// It reports false if it doesn't recognize the error.
+//
+// Use Extractors with [RegisterExtractor] to teach multierr about errors
+// that combine other errors in ways it doesn't recognize out of the box.
+type Extractor func(err error) (errs []error, ok bool)
+
+// hashicorpGroup matches the multi-error interface of
+// github.com/hashicorp/go-multierror.
+type hashicorpGroup interface {
+	WrappedErrors() []error
+}
+
+var (
+	// Extractors registered with RegisterExtractor, in registration
+	// order. This is replaced as a whole on every registration so that
+	// readers don't need to take a lock.
+	_extractors   atomic.Pointer[[]Extractor]
+	_extractorsMu sync.Mutex // serializes registrations
+
+	_errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// RegisterExtractor adds an Extractor used by [Errors], [Every], [Len],
+// [At] and other functions of this package to find the errors inside an
+// error.
+//
+//	multierr.RegisterExtractor(func(err error) ([]error, bool) {
+//		if v, ok := err.(*validation.Errors); ok {
+//			return v.List(), true
+//		}
+//		return nil, false
+//	})
+//
+// Errors that implement the Go 1.20 multi-error interface, Unwrap() []error,
+// are always recognized first. Registered Extractors are consulted next in
+// the order they were registered. The following shapes are recognized after
+// that without registration:
+//
+//   - errors with an Errors() []error method, such as errors produced by
+//     older versions of this package
+//   - errors with a WrappedErrors() []error method, such as those produced
+//     by github.com/hashicorp/go-multierror
+//   - errors that are slices of errors, such as go/scanner.ErrorList
+//
+// RegisterExtractor is typically called from an init function. It's safe
+// for concurrent use.
+func RegisterExtractor(extract Extractor) {
+	_extractorsMu.Lock()
+	defer _extractorsMu.Unlock()
+
+	var extractors []Extractor
+	if old := _extractors.Load(); old != nil {
+		extractors = append(extractors, *old...)
+	}
+	extractors = append(extractors, extract)
+	_extractors.Store(&extractors)
+}
+
+// extractOther finds the errors inside errors that don't implement the Go
+// 1.20 multi-error interface and aren't slices of errors.
+func extractOther(err error) ([]error, bool) {
+	if extractors := _extractors.Load(); extractors != nil {
+		for _, extract := range *extractors {
+			if errs, ok := extract(err); ok {
+				return errs, true
+			}
+		}
+	}
+
+	switch err := err.(type) {
+	case errorGroup:
+		return err.Errors(), true
+	case hashicorpGroup:
+		return err.WrappedErrors(), true
+	}
+	return nil, false
+}
+
+// errorSlice reports whether the underlying type of err is a slice of
+// errors, such as go/scanner.ErrorList, that holds at least one non-nil
+// error.
+//
+// Use sliceLen, sliceAt and sliceErrors to access the errors inside it.
+// They skip nil elements.
+func errorSlice(err error) (reflect.Value, bool) {
+	v := reflect.ValueOf(err)
+	if v.Kind() != reflect.Slice || !v.Type().Elem().Implements(_errorType) {
+		return reflect.Value{}, false
+	}
+	for i := 0; i < v.Len(); i++ {
+		if !isNilValue(v.Index(i)) {
+			return v, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// sliceLen returns the number of non-nil errors in a slice of errors. It
+// walks the slice if its elements may be nil.
+func sliceLen(v reflect.Value) int {
+	if !isNillable(v.Type().Elem().Kind()) {
+		return v.Len()
+	}
+
+	var n int
+	for i := 0; i < v.Len(); i++ {
+		if !v.Index(i).IsNil() {
+			n++
+		}
+	}
+	return n
+}
+
+// sliceAt returns the non-nil error at index i in a slice of errors, not
+// counting nil elements, or nil if there is no such error. It walks the
+// slice up to that error if its elements may be nil.
+func sliceAt(v reflect.Value, i int) error {
+	if i < 0 || i >= v.Len() {
+		return nil
+	}
+	if !isNillable(v.Type().Elem().Kind()) {
+		return v.Index(i).Interface().(error)
+	}
+
+	for j := 0; j < v.Len(); j++ {
+		item := v.Index(j)
+		if item.IsNil() {
+			continue
+		}
+		if i == 0 {
+			return item.Interface().(error)
+		}
+		i--
+	}
+	return nil
+}
+
+// sliceErrors copies the non-nil errors of a slice of errors into a new
+// list.
+func sliceErrors(v reflect.Value) []error {
+	errs := make([]error, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item := v.Index(i)
+		if isNilValue(item) {
+			continue
+		}
+		errs = append(errs, item.Interface().(error))
+	}
+	return errs
+}
+
+func isNilValue(v reflect.Value) bool {
+	return isNillable(v.Kind()) && v.IsNil()
+}
+
+func isNillable(k reflect.Kind) bool {
+	switch k {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return true
+	}
+	return false
+}
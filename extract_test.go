@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"go/scanner"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// hashicorpError mimics *multierror.Error from
+// github.com/hashicorp/go-multierror.
+type hashicorpError struct{ errs []error }
+
+func (e *hashicorpError) Error() string { return "hashicorp" }
+
+func (e *hashicorpError) WrappedErrors() []error { return e.errs }
+
+// customGroup is an error that is only recognized with a registered
+// Extractor.
+type customGroup struct{ first, second error }
+
+func (customGroup) Error() string { return "custom group" }
+
+func init() {
+	RegisterExtractor(func(err error) ([]error, bool) {
+		if g, ok := err.(customGroup); ok {
+			return []error{g.first, g.second}, true
+		}
+		return nil, false
+	})
+}
+
+func TestExtractors(t *testing.T) {
+	var scanErrs scanner.ErrorList
+	scanErrs.Add(token.Position{Line: 1}, "foo")
+	scanErrs.Add(token.Position{Line: 2}, "bar")
+
+	tests := []struct {
+		desc string
+		give error
+		want []error
+	}{
+		{
+			desc: "errorGroup",
+			give: notMultiErr{},
+			want: []error{errors.New("great sadness")},
+		},
+		{
+			desc: "WrappedErrors",
+			give: &hashicorpError{errs: []error{errors.New("foo"), errors.New("bar")}},
+			want: []error{errors.New("foo"), errors.New("bar")},
+		},
+		{
+			desc: "slice",
+			give: scanErrs,
+			want: []error{scanErrs[0], scanErrs[1]},
+		},
+		{
+			desc: "slice with nil",
+			give: scanner.ErrorList{nil, scanErrs[0]},
+			want: []error{scanErrs[0]},
+		},
+		{
+			desc: "registered",
+			give: customGroup{errors.New("foo"), errors.New("bar")},
+			want: []error{errors.New("foo"), errors.New("bar")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, Errors(tt.give))
+			assert.Equal(t, len(tt.want), Len(tt.give))
+			for i, want := range tt.want {
+				assert.Equal(t, want, At(tt.give, i))
+			}
+		})
+	}
+}
+
+// emptyGroup is an error with an empty list of errors.
+type emptyGroup struct{}
+
+func (emptyGroup) Error() string   { return "empty group" }
+func (emptyGroup) Errors() []error { return nil }
+
+// emptyJoin is a Go 1.20 multi-error with an empty list of errors.
+type emptyJoin struct{}
+
+func (emptyJoin) Error() string   { return "empty join" }
+func (emptyJoin) Unwrap() []error { return nil }
+
+func TestExtractorsEmptyList(t *testing.T) {
+	tests := []struct {
+		desc string
+		give error
+	}{
+		{desc: "errorGroup", give: emptyGroup{}},
+		{desc: "WrappedErrors", give: &hashicorpError{}},
+		{desc: "Unwrap", give: emptyJoin{}},
+		{desc: "slice", give: scanner.ErrorList{}},
+		{desc: "slice of nils", give: scanner.ErrorList{nil, nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			// An error with an empty list of errors is a plain error.
+			assert.Equal(t, []error{tt.give}, Errors(tt.give))
+			assert.Equal(t, 1, Len(tt.give))
+			assert.Equal(t, tt.give, At(tt.give, 0))
+		})
+	}
+}
+
+func TestSliceLenAtDoNotAllocate(t *testing.T) {
+	var list scanner.ErrorList
+	for i := 0; i < 100; i++ {
+		list.Add(token.Position{Line: i}, "foo")
+	}
+	list[50] = nil
+	var err error = list
+
+	assert.Equal(t, 99, Len(err))
+	assert.Same(t, list[51], At(err, 50), "nil elements must be skipped")
+
+	allocs := testing.AllocsPerRun(10, func() {
+		for i := 0; i < Len(err); i++ {
+			_ = At(err, i)
+		}
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestEveryWithExtractors(t *testing.T) {
+	errFoo := errors.New("foo")
+
+	assert.True(t, Every(&hashicorpError{errs: []error{errFoo, errFoo}}, errFoo))
+	assert.False(t, Every(&hashicorpError{errs: []error{errFoo, errors.New("bar")}}, errFoo))
+	assert.True(t, Every(customGroup{errFoo, errFoo}, errFoo))
+	assert.False(t, Every(customGroup{errFoo, errors.New("bar")}, errFoo))
+}
+
+func TestErrorSliceIgnoresOtherSlices(t *testing.T) {
+	_, ok := errorSlice(stringsError{"foo", "bar"})
+	assert.False(t, ok)
+}
+
+type stringsError []string
+
+func (stringsError) Error() string { return "strings" }
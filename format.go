@@ -0,0 +1,263 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Multiline renders errors in the readable multi-line format used by %+v
+// instead of the semicolon-delimited single-line format.
+func Multiline() Option {
+	return optionFunc(func(o *options) {
+		o.format.multiline = true
+	})
+}
+
+// Numbered renders errors in a multi-line format where each error is
+// prefixed with its position in the list, for example "[3/12]". Groups
+// built with [Group] are expanded in place, with their positions qualified
+// by the path to them, for example "[3.1/2]". Other errors are rendered
+// with %+v, even if they wrap multiple errors.
+//
+// This is the format produced by %#v.
+func Numbered() Option {
+	return optionFunc(func(o *options) {
+		o.format.multiline = true
+		o.format.numbered = true
+	})
+}
+
+// MaxBytes limits the rendered message to n bytes. If the message is longer,
+// it is cut short and followed by a marker reporting the number of bytes
+// that were elided. n <= 0 means no limit.
+func MaxBytes(n int) Option {
+	return optionFunc(func(o *options) {
+		o.format.maxBytes = n
+	})
+}
+
+// MaxItemBytes limits the rendered message of each individual error to n
+// bytes. Longer messages are cut short and followed by a marker reporting
+// the number of bytes that were elided. n <= 0 means no limit.
+func MaxItemBytes(n int) Option {
+	return optionFunc(func(o *options) {
+		o.format.maxItemBytes = n
+	})
+}
+
+type formatOptions struct {
+	multiline    bool
+	numbered     bool
+	maxBytes     int
+	maxItemBytes int
+}
+
+func newFormatOptions(opts []Option) formatOptions {
+	return newOptions(opts).format
+}
+
+// truncateItem shortens the message of a single error to the configured
+// maximum.
+func (o formatOptions) truncateItem(s string) string {
+	if o.maxItemBytes <= 0 || len(s) <= o.maxItemBytes {
+		return s
+	}
+
+	cut := runeBoundary(s, o.maxItemBytes)
+	if s[cut] != '\n' {
+		// Cut multi-line messages on a line boundary if possible.
+		if idx := strings.LastIndexByte(s[:cut], '\n'); idx > 0 {
+			cut = idx
+		}
+	}
+	return s[:cut] + elidedMarker(len(s)-cut)
+}
+
+// WriteTo writes the message of the given error to w without building it in
+// memory first. Use it to render errors holding a very large number of
+// failures into files or network responses.
+//
+//	multierr.WriteTo(w, err, multierr.Multiline(), multierr.MaxBytes(1<<20))
+//
+// By default, WriteTo renders the same single-line message as err.Error().
+// Pass [Multiline] to render the multi-line message produced by %+v instead.
+// Use [MaxBytes] and [MaxItemBytes] to limit how much is written.
+//
+// WriteTo returns the number of bytes written to w and the first error
+// encountered while writing, if any. Nothing is written if err is nil.
+func WriteTo(w io.Writer, err error, opts ...Option) (int64, error) {
+	if err == nil {
+		return 0, nil
+	}
+
+	cw := &countWriter{w: w}
+	newFormatOptions(opts).writeTo(cw, err)
+	return cw.n, cw.err
+}
+
+// WithFormat returns an error that renders the given error with the provided
+// options wherever its message is requested: in Error(), and when formatted
+// with %v, %+v or %#v.
+//
+//	err = multierr.WithFormat(err, multierr.MaxItemBytes(256), multierr.MaxBytes(4096))
+//
+// The returned error is transparent to [Errors], errors.Is and errors.As. It
+// returns nil if err is nil.
+//
+// Options apply only to the returned error. If it's combined with other
+// errors later, it will be rendered as a single item of the combined error,
+// still using these options.
+func WithFormat(err error, opts ...Option) error {
+	if err == nil {
+		return nil
+	}
+	return &formattedError{err: err, opts: newFormatOptions(opts)}
+}
+
+// formattedError is an error rendered with custom formatting options.
+type formattedError struct {
+	err  error
+	opts formatOptions
+}
+
+func (e *formattedError) Error() string {
+	buff := _bufferPool.Get().(*bytes.Buffer)
+	buff.Reset()
+
+	e.opts.writeTo(buff, e.err)
+
+	result := buff.String()
+	_bufferPool.Put(buff)
+	return result
+}
+
+func (e *formattedError) Format(f fmt.State, c rune) {
+	opts := e.opts
+	if c == 'v' && f.Flag('#') {
+		opts.multiline = true
+		opts.numbered = true
+	} else if c == 'v' && f.Flag('+') {
+		opts.multiline = true
+	}
+	opts.writeTo(f, e.err)
+}
+
+// Unwrap returns the list of errors that the wrapped error is composed of.
+func (e *formattedError) Unwrap() []error {
+	if errs, ok := unwrapErrors(e.err); ok {
+		return errs
+	}
+	return []error{e.err}
+}
+
+// writeTo renders err into w as configured, enforcing the maximum length of
+// the message.
+func (o formatOptions) writeTo(w io.Writer, err error) {
+	lw := &limitWriter{w: w, limit: o.maxBytes}
+	o.write(lw, err)
+	if lw.elided > 0 {
+		io.WriteString(w, elidedMarker(lw.elided))
+	}
+}
+
+// write renders err into w as configured.
+func (o formatOptions) write(w io.Writer, err error) {
+	merr, ok := err.(*multiError)
+	switch {
+	case ok && o.multiline:
+		merr.writeMultiline(w, o)
+	case ok:
+		merr.writeSingleline(w, o)
+	case o.multiline:
+		io.WriteString(w, o.truncateItem(fmt.Sprintf("%+v", err)))
+	default:
+		io.WriteString(w, o.truncateItem(err.Error()))
+	}
+}
+
+// limitWriter writes up to limit bytes to the underlying writer, and counts
+// the bytes that didn't fit. A limit of zero or less means no limit.
+type limitWriter struct {
+	w      io.Writer
+	limit  int
+	n      int // bytes accepted so far
+	elided int // bytes dropped after reaching the limit
+}
+
+func (lw *limitWriter) Write(b []byte) (int, error) {
+	total := len(b)
+	if lw.limit > 0 && (lw.elided > 0 || lw.n+len(b) > lw.limit) {
+		// Once anything was dropped, drop everything that follows so
+		// that the output is never discontinuous.
+		room := 0
+		if lw.elided == 0 {
+			room = runeBoundary(b, lw.limit-lw.n)
+		}
+		lw.elided += len(b) - room
+		b = b[:room]
+	}
+	lw.n += len(b)
+	lw.w.Write(b)
+	return total, nil
+}
+
+// countWriter counts the bytes written to the underlying writer, and
+// remembers the first error it failed with. Once a write fails, all further
+// writes are dropped.
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countWriter) Write(b []byte) (int, error) {
+	if cw.err != nil || len(b) == 0 {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
+
+// runeBoundary returns the largest index no greater than n that doesn't
+// split a UTF-8 encoded rune in s.
+func runeBoundary[S ~string | ~[]byte](s S, n int) int {
+	if n >= len(s) {
+		return len(s)
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return n
+}
+
+// elidedMarker returns the marker placed after a message that was cut
+// short.
+func elidedMarker(n int) string {
+	return "... (" + strconv.Itoa(n) + " bytes elided)"
+}
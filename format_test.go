@@ -0,0 +1,239 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteTo(t *testing.T) {
+	tests := []struct {
+		desc string
+		give error
+		opts []Option
+		want string
+	}{
+		{desc: "nil"},
+		{
+			desc: "single error",
+			give: errors.New("great sadness"),
+			want: "great sadness",
+		},
+		{
+			desc: "single error/multiline",
+			give: richFormatError{},
+			opts: []Option{Multiline()},
+			want: "multiline\nmessage\nwith plus",
+		},
+		{
+			desc: "multiple errors",
+			give: Combine(errors.New("foo"), richFormatError{}, errors.New("bar")),
+			want: "foo; without plus; bar",
+		},
+		{
+			desc: "multiple errors/multiline",
+			give: Combine(errors.New("foo"), richFormatError{}, errors.New("bar")),
+			opts: []Option{Multiline()},
+			want: "the following errors occurred:\n" +
+				" -  foo\n" +
+				" -  multiline\n" +
+				"    message\n" +
+				"    with plus\n" +
+				" -  bar",
+		},
+		{
+			desc: "max bytes",
+			give: Combine(errors.New("foo"), errors.New("bar"), errors.New("baz")),
+			opts: []Option{MaxBytes(7)},
+			want: "foo; ba... (6 bytes elided)",
+		},
+		{
+			desc: "max bytes/not reached",
+			give: Combine(errors.New("foo"), errors.New("bar")),
+			opts: []Option{MaxBytes(8)},
+			want: "foo; bar",
+		},
+		{
+			desc: "max bytes/rune boundary",
+			give: Combine(errors.New("foo"), errors.New("ünïcode")),
+			opts: []Option{MaxBytes(6)},
+			want: "foo; ... (9 bytes elided)",
+		},
+		{
+			desc: "max item bytes",
+			give: Combine(errors.New("foo"), errors.New(strings.Repeat("x", 100))),
+			opts: []Option{MaxItemBytes(4)},
+			want: "foo; xxxx... (96 bytes elided)",
+		},
+		{
+			desc: "max item bytes/single error",
+			give: errors.New("great sadness"),
+			opts: []Option{MaxItemBytes(5)},
+			want: "great... (8 bytes elided)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var buff bytes.Buffer
+			n, err := WriteTo(&buff, tt.give, tt.opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, buff.String())
+			assert.Equal(t, int64(buff.Len()), n)
+		})
+	}
+}
+
+func TestWriteToMatchesFormat(t *testing.T) {
+	err := Combine(errors.New("foo"), richFormatError{}, errors.New("bar"))
+
+	var buff bytes.Buffer
+	WriteTo(&buff, err)
+	assert.Equal(t, err.Error(), buff.String())
+
+	buff.Reset()
+	WriteTo(&buff, err, Multiline())
+	assert.Equal(t, fmt.Sprintf("%+v", err), buff.String())
+}
+
+type failingWriter struct {
+	limit int
+	buff  bytes.Buffer
+}
+
+func (w *failingWriter) Write(b []byte) (int, error) {
+	if w.buff.Len()+len(b) > w.limit {
+		n := w.limit - w.buff.Len()
+		w.buff.Write(b[:n])
+		return n, errors.New("great sadness")
+	}
+	return w.buff.Write(b)
+}
+
+func TestWriteToWriterFailure(t *testing.T) {
+	w := failingWriter{limit: 5}
+	n, err := WriteTo(&w, Combine(errors.New("foo"), errors.New("bar")))
+	assert.EqualError(t, err, "great sadness")
+	assert.Equal(t, int64(5), n)
+	assert.Equal(t, "foo; ", w.buff.String())
+}
+
+func TestWithFormat(t *testing.T) {
+	body := errors.New("unexpected response: " + strings.Repeat("x", 100))
+	err := WithFormat(
+		Combine(errors.New("foo"), body, richFormatError{}),
+		MaxItemBytes(30),
+	)
+
+	wantSingleline := "foo; unexpected response: xxxxxxxxx... (91 bytes elided); without plus"
+	assert.Equal(t, wantSingleline, err.Error())
+	assert.Equal(t, wantSingleline, fmt.Sprintf("%v", err))
+	assert.Equal(t, "the following errors occurred:\n"+
+		" -  foo\n"+
+		" -  unexpected response: xxxxxxxxx... (91 bytes elided)\n"+
+		" -  multiline\n"+
+		"    message\n"+
+		"    with plus",
+		fmt.Sprintf("%+v", err))
+
+	assert.ErrorIs(t, err, body)
+	var rich richFormatError
+	assert.ErrorAs(t, err, &rich)
+	assert.Equal(t, []error{errors.New("foo"), body, richFormatError{}}, Errors(err))
+}
+
+func TestWithFormatMaxBytes(t *testing.T) {
+	err := WithFormat(
+		Combine(errors.New("foo"), errors.New("bar"), errors.New("baz")),
+		MaxBytes(7),
+	)
+	assert.Equal(t, "foo; ba... (6 bytes elided)", err.Error())
+	assert.Equal(t, "foo; ba... (6 bytes elided)", fmt.Sprintf("%v", err))
+	assert.Equal(t, "the fol... (47 bytes elided)", fmt.Sprintf("%+v", err))
+}
+
+func TestWithFormatSingleError(t *testing.T) {
+	assert.Nil(t, WithFormat(nil, MaxBytes(10)))
+
+	give := errors.New("great sadness")
+	err := WithFormat(give, MaxItemBytes(5))
+	assert.Equal(t, "great... (8 bytes elided)", err.Error())
+	assert.ErrorIs(t, err, give)
+	assert.Equal(t, []error{give}, Errors(err))
+}
+
+func TestTruncateItem(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		max  int
+		want string
+	}{
+		{
+			desc: "no limit",
+			give: "foo bar",
+			want: "foo bar",
+		},
+		{
+			desc: "fits",
+			give: "foo bar",
+			max:  7,
+			want: "foo bar",
+		},
+		{
+			desc: "cut",
+			give: "foo bar",
+			max:  4,
+			want: "foo ... (3 bytes elided)",
+		},
+		{
+			desc: "multiline/cut on line boundary",
+			give: "foo\nbar\nbaz",
+			max:  9,
+			want: "foo\nbar... (4 bytes elided)",
+		},
+		{
+			desc: "multiline/limit at end of line",
+			give: "foo\nbar\nbaz",
+			max:  7,
+			want: "foo\nbar... (4 bytes elided)",
+		},
+		{
+			desc: "multiline/first line too long",
+			give: "foo bar\nbaz",
+			max:  5,
+			want: "foo b... (6 bytes elided)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			opts := formatOptions{maxItemBytes: tt.max}
+			assert.Equal(t, tt.want, opts.truncateItem(tt.give))
+		})
+	}
+}
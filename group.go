@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Group combines the given errors into a labeled group. Unlike other
+// multierr errors, a group is not flattened when combined with other errors
+// by [Combine] or [Append]: it remains a single element that preserves the
+// structure of the result.
+//
+//	err := multierr.Combine(
+//		multierr.Group("db cleanup errors", db.Close(), tx.Rollback()),
+//		multierr.Group("cache cleanup errors", cache.Flush()),
+//	)
+//
+// A group formats to its label followed by its errors with %v, for example
+// "db cleanup errors: connection reset; tx done". With %+v, it formats into
+// a titled block that is nested inside the multi-line message of the error
+// it was combined into.
+//
+//	the following errors occurred:
+//	 -  db cleanup errors:
+//	     -  connection reset
+//	     -  tx done
+//	 -  cache cleanup errors:
+//	     -  write failed
+//
+// errors.Is and errors.As match the errors inside the group, and [Errors]
+// returns them when given the group itself.
+//
+// nil errors are ignored. If all errors are nil, Group returns nil.
+func Group(label string, errs ...error) error {
+	var inner []error
+	switch err := fromSlice(errs).(type) {
+	case nil:
+		return nil
+	case *multiError:
+		inner = err.errors
+	default:
+		inner = []error{err}
+	}
+	return &groupError{label: label, errors: inner}
+}
+
+// groupError is a labeled group of errors that isn't flattened.
+type groupError struct {
+	label  string
+	errors []error // non-empty
+}
+
+func (g *groupError) Error() string {
+	buff := _bufferPool.Get().(*bytes.Buffer)
+	buff.Reset()
+
+	io.WriteString(buff, g.label)
+	io.WriteString(buff, ": ")
+	for i, item := range g.errors {
+		if i > 0 {
+			buff.Write(_singlelineSeparator)
+		}
+		io.WriteString(buff, item.Error())
+	}
+
+	result := buff.String()
+	_bufferPool.Put(buff)
+	return result
+}
+
+// Unwrap returns the errors inside the group.
+func (g *groupError) Unwrap() []error {
+	return g.errors
+}
+
+func (g *groupError) Format(f fmt.State, c rune) {
+	if c != 'v' || !f.Flag('+') {
+		io.WriteString(f, g.Error())
+		return
+	}
+
+	io.WriteString(f, g.label)
+	io.WriteString(f, ":")
+	for _, item := range g.errors {
+		f.Write(_multilineSeparator)
+		writePrefixLine(f, _multilineIndent, fmt.Sprintf("%+v", item))
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, Group("foo"))
+		assert.Nil(t, Group("foo", nil, nil))
+	})
+
+	t.Run("single error", func(t *testing.T) {
+		err := Group("db cleanup errors", errors.New("connection reset"))
+		assert.Equal(t, "db cleanup errors: connection reset", err.Error())
+		assert.Equal(t, "db cleanup errors:\n -  connection reset", fmt.Sprintf("%+v", err))
+	})
+
+	t.Run("flattens its own errors", func(t *testing.T) {
+		err := Group("foo", Combine(errors.New("a"), errors.New("b")), nil, errors.New("c"))
+		assert.Equal(t, []error{errors.New("a"), errors.New("b"), errors.New("c")}, Errors(err))
+	})
+}
+
+func TestGroupCombined(t *testing.T) {
+	errReset := errors.New("connection reset")
+	db := Group("db cleanup errors", errReset, errors.New("tx done"))
+	cache := Group("cache cleanup errors", richFormatError{})
+
+	err := Combine(db, cache, errors.New("other"))
+	require.Equal(t, []error{db, cache, errors.New("other")}, Errors(err),
+		"groups must not be flattened")
+
+	err = Append(err, Group("more", errors.New("foo")))
+	assert.Equal(t, 4, Len(err))
+
+	assert.Equal(t, "db cleanup errors: connection reset; tx done; "+
+		"cache cleanup errors: without plus; other; more: foo", err.Error())
+	assert.Equal(t, "the following errors occurred:\n"+
+		" -  db cleanup errors:\n"+
+		"     -  connection reset\n"+
+		"     -  tx done\n"+
+		" -  cache cleanup errors:\n"+
+		"     -  multiline\n"+
+		"        message\n"+
+		"        with plus\n"+
+		" -  other\n"+
+		" -  more:\n"+
+		"     -  foo", fmt.Sprintf("%+v", err))
+	assert.Equal(t, "the following errors occurred:\n"+
+		" [1/4] db cleanup errors:\n"+
+		"     [1.1/2] connection reset\n"+
+		"     [1.2/2] tx done\n"+
+		" [2/4] cache cleanup errors:\n"+
+		"     [2.1/1] multiline\n"+
+		"         message\n"+
+		"         with plus\n"+
+		" [3/4] other\n"+
+		" [4/4] more:\n"+
+		"     [4.1/1] foo", fmt.Sprintf("%#v", err))
+
+	assert.ErrorIs(t, err, errReset)
+	var rich richFormatError
+	assert.ErrorAs(t, err, &rich)
+}
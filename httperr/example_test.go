@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httperr_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"go.uber.org/multierr"
+	"go.uber.org/multierr/httperr"
+)
+
+func ExampleWriteProblem() {
+	errMissing := errors.New("must not be empty")
+
+	createUser := func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		if r.FormValue("name") == "" {
+			err = multierr.Append(err, httperr.WithStatus(
+				http.StatusUnprocessableEntity,
+				httperr.WithPointer("#/name", errMissing),
+			))
+		}
+		if r.FormValue("email") == "" {
+			err = multierr.Append(err, httperr.WithStatus(
+				http.StatusUnprocessableEntity,
+				httperr.WithPointer("#/email", errMissing),
+			))
+		}
+		if err != nil {
+			httperr.WriteProblem(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}
+
+	rec := httptest.NewRecorder()
+	createUser(rec, httptest.NewRequest(http.MethodPost, "/users", nil))
+
+	var body bytes.Buffer
+	json.Indent(&body, rec.Body.Bytes(), "", "  ")
+	fmt.Println(rec.Code)
+	fmt.Println(body.String())
+	// Output:
+	// 422
+	// {
+	//   "type": "about:blank",
+	//   "title": "Unprocessable Entity",
+	//   "status": 422,
+	//   "instance": "/users",
+	//   "errors": [
+	//     {
+	//       "detail": "must not be empty",
+	//       "type": "about:blank",
+	//       "pointer": "#/name"
+	//     },
+	//     {
+	//       "detail": "must not be empty",
+	//       "type": "about:blank",
+	//       "pointer": "#/email"
+	//     }
+	//   ]
+	// }
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package httperr renders errors as RFC 9457 problem details.
+//
+// Use WriteProblem to respond to a request with an error. Each error inside
+// a combined error is listed in the "errors" extension member of the
+// response.
+//
+//	func createUser(w http.ResponseWriter, r *http.Request) {
+//		var err error
+//		if u.Name == "" {
+//			err = multierr.Append(err, httperr.WithStatus(
+//				http.StatusUnprocessableEntity,
+//				httperr.WithPointer("#/name", errMissing),
+//			))
+//		}
+//		// ...
+//		if err != nil {
+//			httperr.WriteProblem(w, r, err)
+//			return
+//		}
+//	}
+//
+// The status code of the response is chosen with multierr.StatusCode from
+// errors that implement a StatusCode() int method, such as those built with
+// [WithStatus]. It defaults to 500.
+//
+// To avoid leaking internal details to clients, the messages of server
+// errors, which have a 5xx status code or none at all, are replaced with
+// generic ones. Use [ExposeServerErrors] to report them as-is.
+//
+// Alternatively, write handlers that return errors as a HandlerFunc.
+//
+//	http.Handle("/users", httperr.HandlerFunc(createUser))
+package httperr // import "go.uber.org/multierr/httperr"
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/multierr"
+)
+
+// ContentType is the media type of problem details responses.
+const ContentType = "application/problem+json"
+
+// _defaultType is the problem type used when none is specified.
+const _defaultType = "about:blank"
+
+// Problem is the body of a problem details response.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+
+	// Errors lists the individual errors that caused the problem.
+	Errors []ProblemError `json:"errors"`
+}
+
+// ProblemError describes an individual error inside a Problem.
+type ProblemError struct {
+	Detail string `json:"detail"`
+	Type   string `json:"type"`
+
+	// JSON pointer to the part of the request that caused the error, if
+	// any. See RFC 6901.
+	Pointer string `json:"pointer,omitempty"`
+}
+
+// Option customizes [NewProblem] and [WriteProblem].
+type Option interface {
+	applyOption(*options)
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) applyOption(o *options) { f(o) }
+
+type options struct {
+	exposeServerErrors bool
+}
+
+// ExposeServerErrors reports the messages of server errors to clients
+// instead of replacing them with generic messages. Use it only if the
+// messages of these errors are known to be safe to share, for example in
+// development environments.
+func ExposeServerErrors() Option {
+	return optionFunc(func(o *options) {
+		o.exposeServerErrors = true
+	})
+}
+
+// NewProblem builds the problem details for the given error. r may be nil.
+// See [WriteProblem] for how the fields are filled in.
+func NewProblem(r *http.Request, err error, opts ...Option) Problem {
+	var o options
+	for _, opt := range opts {
+		opt.applyOption(&o)
+	}
+
+	status := multierr.StatusCode(err, http.StatusInternalServerError)
+	p := Problem{
+		Type:   _defaultType,
+		Title:  http.StatusText(status),
+		Status: status,
+		Errors: []ProblemError{},
+	}
+	if r != nil && r.URL != nil {
+		p.Instance = r.URL.Path
+	}
+
+	for _, e := range multierr.Errors(err) {
+		p.Errors = append(p.Errors, ProblemError{
+			Detail:  o.detailOf(e),
+			Type:    typeOf(e),
+			Pointer: pointerOf(e),
+		})
+	}
+	if len(p.Errors) == 1 {
+		p.Detail = p.Errors[0].Detail
+		p.Type = p.Errors[0].Type
+	}
+	return p
+}
+
+// detailOf returns the message of err to report to clients.
+func (o options) detailOf(err error) string {
+	if code := multierr.StatusCode(err, http.StatusInternalServerError); code >= 500 && !o.exposeServerErrors {
+		return http.StatusText(code)
+	}
+	return err.Error()
+}
+
+// WriteProblem responds to the request with the problem details for the
+// given error, encoded as application/problem+json.
+//
+// The response lists every error inside err in the "errors" member, along
+// with its type and the JSON pointer to the field that caused it. Use
+// [WithType] and [WithPointer] to specify these, and [WithStatus] to
+// choose the status code of the response. If err holds a single
+// error, its message and type also become the "detail" and "type" of the
+// response.
+//
+// The messages of server errors are replaced with the text of their status
+// code, for example "Internal Server Error", unless [ExposeServerErrors] is
+// given.
+//
+// WriteProblem returns the error reported by the ResponseWriter, if any.
+func WriteProblem(w http.ResponseWriter, r *http.Request, err error, opts ...Option) error {
+	p := NewProblem(r, err, opts...)
+
+	body, merr := json.Marshal(p)
+	if merr != nil {
+		return merr
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	_, werr := w.Write(body)
+	return werr
+}
+
+// HandlerFunc is an HTTP handler that may fail with an error. If it returns
+// a non-nil error without having written a response, the error is written
+// with [WriteProblem].
+//
+//	http.Handle("/users", httperr.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+//		// ...
+//	}))
+type HandlerFunc func(http.ResponseWriter, *http.Request) error
+
+var _ http.Handler = HandlerFunc(nil)
+
+// ServeHTTP calls f and writes its error, if any.
+func (f HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rw := &responseWriter{ResponseWriter: w}
+	if err := f(rw, r); err != nil && !rw.wroteHeader {
+		WriteProblem(w, r, err)
+	}
+}
+
+// responseWriter records whether a response was started.
+type responseWriter struct {
+	http.ResponseWriter
+
+	wroteHeader bool
+}
+
+var _ http.Flusher = (*responseWriter)(nil)
+
+func (w *responseWriter) WriteHeader(status int) {
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush sends any buffered data to the client, which starts the response.
+func (w *responseWriter) Flush() {
+	_ = w.FlushError()
+}
+
+// FlushError is the variant of Flush used by http.ResponseController.
+func (w *responseWriter) FlushError() error {
+	w.wroteHeader = true
+	return http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap returns the underlying ResponseWriter for use with
+// http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// WithType attaches a problem type URI to the given error. It is reported
+// as the "type" of the error in problem details. It returns nil if err is
+// nil.
+func WithType(typeURI string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &typedError{typeURI: typeURI, err: err}
+}
+
+// WithPointer attaches a JSON pointer (RFC 6901) to the given error,
+// identifying the part of the request that caused it. It is reported as the
+// "pointer" of the error in problem details. It returns nil if err is nil.
+//
+//	httperr.WithPointer("#/user/email", errInvalidEmail)
+func WithPointer(pointer string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &pointerError{pointer: pointer, err: err}
+}
+
+// WithStatus attaches an HTTP status code to the given error. It is used to
+// choose the status code of the response, and whether the message of the
+// error is exposed. It returns nil if err is nil.
+//
+//	httperr.WithStatus(http.StatusConflict, errDuplicateEmail)
+func WithStatus(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &statusCodeError{code: code, err: err}
+}
+
+type typedError struct {
+	typeURI string
+	err     error
+}
+
+func (e *typedError) Error() string { return e.err.Error() }
+func (e *typedError) Unwrap() error { return e.err }
+
+type pointerError struct {
+	pointer string
+	err     error
+}
+
+func (e *pointerError) Error() string { return e.err.Error() }
+func (e *pointerError) Unwrap() error { return e.err }
+
+type statusCodeError struct {
+	code int
+	err  error
+}
+
+func (e *statusCodeError) Error() string   { return e.err.Error() }
+func (e *statusCodeError) Unwrap() error   { return e.err }
+func (e *statusCodeError) StatusCode() int { return e.code }
+
+func typeOf(err error) string {
+	var terr *typedError
+	if errors.As(err, &terr) {
+		return terr.typeURI
+	}
+	return _defaultType
+}
+
+func pointerOf(err error) string {
+	var perr *pointerError
+	if errors.As(err, &perr) {
+		return perr.pointer
+	}
+	return ""
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package httperr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+// statusError is an error with an HTTP status code.
+type statusError struct {
+	msg  string
+	code int
+}
+
+func (e *statusError) Error() string   { return e.msg }
+func (e *statusError) StatusCode() int { return e.code }
+
+func decodeProblem(t *testing.T, rec *httptest.ResponseRecorder) Problem {
+	t.Helper()
+
+	assert.Equal(t, ContentType, rec.Header().Get("Content-Type"))
+
+	var p Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p), "body: %s", rec.Body)
+	assert.Equal(t, rec.Code, p.Status, "status in body must match response")
+	return p
+}
+
+func TestWriteProblem(t *testing.T) {
+	tests := []struct {
+		desc string
+		give error
+		opts []Option
+		want Problem
+	}{
+		{
+			desc: "single error",
+			give: errors.New("great sadness"),
+			want: Problem{
+				Type:     "about:blank",
+				Title:    "Internal Server Error",
+				Status:   500,
+				Detail:   "Internal Server Error",
+				Instance: "/users",
+				Errors: []ProblemError{
+					{Detail: "Internal Server Error", Type: "about:blank"},
+				},
+			},
+		},
+		{
+			desc: "single error exposed",
+			give: errors.New("great sadness"),
+			opts: []Option{ExposeServerErrors()},
+			want: Problem{
+				Type:     "about:blank",
+				Title:    "Internal Server Error",
+				Status:   500,
+				Detail:   "great sadness",
+				Instance: "/users",
+				Errors: []ProblemError{
+					{Detail: "great sadness", Type: "about:blank"},
+				},
+			},
+		},
+		{
+			desc: "single typed error",
+			give: WithType("https://example.com/probs/quota",
+				&statusError{msg: "out of quota", code: 429}),
+			want: Problem{
+				Type:     "https://example.com/probs/quota",
+				Title:    "Too Many Requests",
+				Status:   429,
+				Detail:   "out of quota",
+				Instance: "/users",
+				Errors: []ProblemError{
+					{Detail: "out of quota", Type: "https://example.com/probs/quota"},
+				},
+			},
+		},
+		{
+			desc: "validation errors",
+			give: multierr.Combine(
+				WithPointer("#/name", &statusError{msg: "name is required", code: 400}),
+				WithType("https://example.com/probs/format",
+					WithPointer("#/emails/1", &statusError{msg: "invalid email", code: 422})),
+			),
+			want: Problem{
+				Type:     "about:blank",
+				Title:    "Bad Request",
+				Status:   400,
+				Instance: "/users",
+				Errors: []ProblemError{
+					{Detail: "name is required", Type: "about:blank", Pointer: "#/name"},
+					{Detail: "invalid email", Type: "https://example.com/probs/format", Pointer: "#/emails/1"},
+				},
+			},
+		},
+		{
+			desc: "server error wins",
+			give: multierr.Combine(
+				&statusError{msg: "bad request", code: 400},
+				errors.New("database unavailable"),
+				&statusError{msg: "upstream failed", code: 502},
+			),
+			want: Problem{
+				Type:     "about:blank",
+				Title:    "Bad Gateway",
+				Status:   502,
+				Instance: "/users",
+				Errors: []ProblemError{
+					{Detail: "bad request", Type: "about:blank"},
+					{Detail: "Internal Server Error", Type: "about:blank"},
+					{Detail: "Bad Gateway", Type: "about:blank"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/users?dry_run=1", nil)
+
+			require.NoError(t, WriteProblem(rec, req, tt.give, tt.opts...))
+			assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+			assert.Equal(t, tt.want, decodeProblem(t, rec))
+		})
+	}
+}
+
+func TestWriteProblemJSON(t *testing.T) {
+	rec := httptest.NewRecorder()
+	err := multierr.Combine(
+		WithPointer("#/name", &statusError{msg: "name is required", code: 400}),
+		&statusError{msg: "age is required", code: 400},
+	)
+	require.NoError(t, WriteProblem(rec, nil, err))
+
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Bad Request",
+		"status": 400,
+		"errors": [
+			{"detail": "name is required", "type": "about:blank", "pointer": "#/name"},
+			{"detail": "age is required", "type": "about:blank"}
+		]
+	}`, rec.Body.String())
+}
+
+func TestNewProblemNil(t *testing.T) {
+	p := NewProblem(nil, nil)
+	assert.Equal(t, 500, p.Status)
+	assert.Empty(t, p.Detail)
+	assert.NotNil(t, p.Errors, "errors must be encoded as an empty list")
+	assert.Empty(t, p.Errors)
+}
+
+func TestNewProblemSuppressed(t *testing.T) {
+	err := multierr.Suppress(
+		&statusError{msg: "invalid name", code: 400},
+		errors.New("close body: connection reset"),
+	)
+
+	p := NewProblem(nil, err)
+	assert.Equal(t, 400, p.Status)
+	assert.Equal(t, []ProblemError{
+		{Detail: "invalid name", Type: "about:blank"},
+	}, p.Errors, "suppressed errors must not be reported to clients")
+}
+
+func TestWithTypeAndPointer(t *testing.T) {
+	assert.NoError(t, WithType("https://example.com", nil))
+	assert.NoError(t, WithPointer("#/foo", nil))
+
+	inner := errors.New("great sadness")
+	err := WithType("https://example.com", WithPointer("#/foo", inner))
+	assert.Equal(t, "great sadness", err.Error())
+	assert.ErrorIs(t, err, inner)
+	assert.Equal(t, "https://example.com", typeOf(err))
+	assert.Equal(t, "#/foo", pointerOf(err))
+}
+
+func TestWithStatus(t *testing.T) {
+	assert.NoError(t, WithStatus(http.StatusConflict, nil))
+
+	inner := errors.New("email already registered")
+	err := WithStatus(http.StatusConflict, WithPointer("#/email", inner))
+	assert.Equal(t, "email already registered", err.Error())
+	assert.ErrorIs(t, err, inner)
+
+	p := NewProblem(nil, err)
+	assert.Equal(t, http.StatusConflict, p.Status)
+	assert.Equal(t, "email already registered", p.Detail, "client errors must be exposed")
+	assert.Equal(t, "#/email", p.Errors[0].Pointer)
+
+	p = NewProblem(nil, WithStatus(http.StatusBadGateway, inner))
+	assert.Equal(t, http.StatusBadGateway, p.Status)
+	assert.Equal(t, "Bad Gateway", p.Detail, "server errors must be hidden")
+}
+
+func TestHandlerFunc(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		h := HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		h := HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			return multierr.Combine(
+				WithPointer("#/a", &statusError{msg: "a is invalid", code: 400}),
+				WithPointer("#/b", &statusError{msg: "b is invalid", code: 400}),
+			)
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("POST", "/things", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		p := decodeProblem(t, rec)
+		assert.Equal(t, "/things", p.Instance)
+		assert.Equal(t, []ProblemError{
+			{Detail: "a is invalid", Type: "about:blank", Pointer: "#/a"},
+			{Detail: "b is invalid", Type: "about:blank", Pointer: "#/b"},
+		}, p.Errors)
+	})
+
+	t.Run("error after response started", func(t *testing.T) {
+		h := HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			w.Write([]byte("partial"))
+			return errors.New("connection lost")
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "partial", rec.Body.String(),
+			"problem must not be appended to a started response")
+	})
+
+	t.Run("error after flush", func(t *testing.T) {
+		h := HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			if err := http.NewResponseController(w).Flush(); err != nil {
+				return err
+			}
+			return errors.New("connection lost")
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		assert.True(t, rec.Flushed)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.String(),
+			"problem must not be appended to a flushed response")
+	})
+
+	t.Run("error after Flusher", func(t *testing.T) {
+		h := HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			w.(http.Flusher).Flush()
+			return errors.New("connection lost")
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		assert.True(t, rec.Flushed)
+		assert.Empty(t, rec.Body.String(),
+			"problem must not be appended to a flushed response")
+	})
+
+	t.Run("response controller", func(t *testing.T) {
+		h := HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			return http.NewResponseController(w).Flush()
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		assert.True(t, rec.Flushed, "Flush must reach the underlying writer")
+	})
+}
+
+func TestHandlerFuncServer(t *testing.T) {
+	srv := httptest.NewServer(HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return &statusError{msg: "not here", code: http.StatusNotFound}
+	}))
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/missing")
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	assert.Equal(t, ContentType, res.Header.Get("Content-Type"))
+
+	var p Problem
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&p))
+	assert.Equal(t, "not here", p.Detail)
+	assert.Equal(t, "/missing", p.Instance)
+}
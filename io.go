@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import "io"
+
+// MultiWriter builds a writer that duplicates its writes to all the given
+// writers, similar to io.MultiWriter.
+//
+// Unlike io.MultiWriter, it does not stop at the first failure. Each write
+// goes to every writer, and the failures of all writers that failed are
+// combined into the returned error. Failures are keyed with the position of
+// the writer in ws; use [Index] to retrieve it.
+//
+//	w := multierr.MultiWriter(file, conn)
+//	if _, err := w.Write(p); err != nil {
+//		for _, err := range multierr.Errors(err) {
+//			i, _ := multierr.Index(err)
+//			log.Printf("writer %d failed: %v", i, err)
+//		}
+//	}
+//
+// If any writer fails, Write reports the fewest bytes written by any writer.
+func MultiWriter(ws ...io.Writer) io.Writer {
+	return &multiWriter{writers: append([]io.Writer(nil), ws...)}
+}
+
+type multiWriter struct {
+	writers []io.Writer
+}
+
+func (mw *multiWriter) Write(p []byte) (n int, err error) {
+	n = len(p)
+	for i, w := range mw.writers {
+		wn, werr := w.Write(p)
+		if werr == nil && wn != len(p) {
+			werr = io.ErrShortWrite
+		}
+		if werr != nil {
+			err = Append(err, keyed(i, werr))
+			if wn < n {
+				n = wn
+			}
+		}
+	}
+	return n, err
+}
+
+// MultiCloser builds an io.Closer that closes all the given closers in order.
+//
+// All closers are closed even if some of them fail. Their failures are
+// combined into the returned error, keyed with the position of the closer
+// in cs; use [Index] to retrieve it. Nil closers are ignored.
+//
+// Use [ReverseCloser] to close them in reverse order instead, and [Close] to
+// close either from a defer.
+//
+//	defer multierr.AppendInvoke(&err, multierr.Close(multierr.MultiCloser(a, b)))
+func MultiCloser(cs ...io.Closer) io.Closer {
+	return &multiCloser{closers: append([]io.Closer(nil), cs...)}
+}
+
+// ReverseCloser is similar to [MultiCloser], but closes the given closers in
+// reverse order, like a sequence of deferred calls would. This is useful
+// when later closers depend on earlier ones, such as a writer wrapping a
+// file. Failures are keyed with the position of the closer in cs.
+func ReverseCloser(cs ...io.Closer) io.Closer {
+	return &multiCloser{closers: append([]io.Closer(nil), cs...), reverse: true}
+}
+
+type multiCloser struct {
+	closers []io.Closer
+	reverse bool
+}
+
+func (mc *multiCloser) Close() (err error) {
+	for j := range mc.closers {
+		i := j
+		if mc.reverse {
+			i = len(mc.closers) - 1 - j
+		}
+		if c := mc.closers[i]; c != nil {
+			err = Append(err, keyed(i, c.Close()))
+		}
+	}
+	return err
+}
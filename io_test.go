@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writerFunc is an io.Writer built from a function.
+type writerFunc func([]byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
+
+func brokenWriter(n int, err error) io.Writer {
+	return writerFunc(func([]byte) (int, error) { return n, err })
+}
+
+func TestMultiWriter(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var a, b bytes.Buffer
+		w := MultiWriter(&a, &b)
+
+		n, err := w.Write([]byte("hello"))
+		require.NoError(t, err)
+		assert.Equal(t, 5, n)
+		assert.Equal(t, "hello", a.String())
+		assert.Equal(t, "hello", b.String())
+	})
+
+	t.Run("no writers", func(t *testing.T) {
+		n, err := MultiWriter().Write([]byte("hello"))
+		require.NoError(t, err)
+		assert.Equal(t, 5, n)
+	})
+
+	t.Run("failures", func(t *testing.T) {
+		var a, b bytes.Buffer
+		errBroken := errors.New("broken pipe")
+		w := MultiWriter(
+			&a,
+			brokenWriter(0, errBroken),
+			&b,
+			brokenWriter(2, nil),
+		)
+
+		n, err := w.Write([]byte("hello"))
+		require.Error(t, err)
+		assert.Equal(t, 0, n, "must report the fewest bytes written")
+		assert.Equal(t, "hello", a.String(), "writers before a failure must be written")
+		assert.Equal(t, "hello", b.String(), "writers after a failure must be written")
+
+		assert.Equal(t, "[1]: broken pipe; [3]: short write", err.Error())
+		assert.ErrorIs(t, err, errBroken)
+		assert.ErrorIs(t, err, io.ErrShortWrite)
+
+		errs := Errors(err)
+		require.Len(t, errs, 2)
+		i, ok := Index(errs[1])
+		assert.True(t, ok)
+		assert.Equal(t, 3, i)
+	})
+
+	t.Run("error with full write", func(t *testing.T) {
+		w := MultiWriter(brokenWriter(5, errors.New("sync failed")))
+		n, err := w.Write([]byte("hello"))
+		assert.Equal(t, 5, n)
+		assert.EqualError(t, err, "[0]: sync failed")
+	})
+
+	t.Run("does not retain caller slice", func(t *testing.T) {
+		var a, b bytes.Buffer
+		ws := []io.Writer{&a}
+		w := MultiWriter(ws...)
+		ws[0] = &b
+
+		_, err := w.Write([]byte("hello"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello", a.String())
+		assert.Empty(t, b.String())
+	})
+}
+
+func TestMultiCloser(t *testing.T) {
+	var order []string
+	closer := func(name string, err error) io.Closer {
+		return closerMock(func() error {
+			order = append(order, name)
+			return err
+		})
+	}
+
+	tests := []struct {
+		desc      string
+		give      func(...io.Closer) io.Closer
+		wantOrder []string
+	}{
+		{desc: "MultiCloser", give: MultiCloser, wantOrder: []string{"a", "b", "c"}},
+		{desc: "ReverseCloser", give: ReverseCloser, wantOrder: []string{"c", "b", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Run("success", func(t *testing.T) {
+				order = nil
+				c := tt.give(closer("a", nil), nil, closer("b", nil), closer("c", nil))
+				require.NoError(t, c.Close())
+				assert.Equal(t, tt.wantOrder, order)
+			})
+
+			t.Run("failures", func(t *testing.T) {
+				order = nil
+				errA := errors.New("a failed")
+				errC := errors.New("c failed")
+				c := tt.give(closer("a", errA), closer("b", nil), closer("c", errC))
+
+				err := c.Close()
+				require.Error(t, err)
+				assert.Equal(t, tt.wantOrder, order, "all closers must be closed")
+				assert.ErrorIs(t, err, errA)
+				assert.ErrorIs(t, err, errC)
+
+				for _, e := range Errors(err) {
+					i, ok := Index(e)
+					require.True(t, ok)
+					switch i {
+					case 0:
+						assert.ErrorIs(t, e, errA)
+					case 2:
+						assert.ErrorIs(t, e, errC)
+					default:
+						t.Errorf("unexpected index %d for %v", i, e)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestMultiCloserWithClose(t *testing.T) {
+	errA := errors.New("a failed")
+
+	var err error
+	func() {
+		defer AppendInvoke(&err, Close(MultiCloser(
+			newCloserMock(t, errA),
+			newCloserMock(t, nil),
+		)))
+	}()
+	assert.EqualError(t, err, "[0]: a failed")
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.23
+
+package multierr
+
+import "iter"
+
+// All returns an iterator over the errors that the supplied error is
+// composed of, along with their positions. Unlike [Errors], All does not
+// copy the underlying list of errors.
+//
+//	for i, err := range multierr.All(err) {
+//		fmt.Printf("error %d: %v\n", i, err)
+//	}
+//
+// If the error is nil, the iterator yields nothing. If the error is not
+// composed of other errors, the iterator yields just the error that was
+// passed in.
+func All(err error) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		if err == nil {
+			return
+		}
+
+		errs, ok := unwrapErrors(err)
+		if !ok {
+			yield(0, err)
+			return
+		}
+
+		for i, e := range errs {
+			if !yield(i, e) {
+				return
+			}
+		}
+	}
+}
+
+// CombineSeq combines the errors produced by the given iterator into a
+// single error. It behaves exactly like [Combine], but does not require the
+// errors to be materialized into a slice first.
+//
+//	err := multierr.CombineSeq(func(yield func(error) bool) {
+//		for _, c := range closers {
+//			if !yield(c.Close()) {
+//				return
+//			}
+//		}
+//	})
+//
+// nil errors are skipped, and any multierr errors are flattened along with
+// the other errors.
+func CombineSeq(seq iter.Seq[error]) error {
+	var errs []error
+	for err := range seq {
+		errs = appendFlattened(errs, err)
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	return &multiError{errors: errs}
+}
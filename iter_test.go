@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.23
+
+package multierr
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	tests := []struct {
+		desc string
+		give error
+		want []error
+	}{
+		{desc: "nil"},
+		{
+			desc: "single error",
+			give: errors.New("foo"),
+			want: []error{errors.New("foo")},
+		},
+		{
+			desc: "multiError",
+			give: Combine(errors.New("foo"), errors.New("bar")),
+			want: []error{errors.New("foo"), errors.New("bar")},
+		},
+		{
+			desc: "errors.Join",
+			give: errors.Join(errors.New("foo"), errors.New("bar")),
+			want: []error{errors.New("foo"), errors.New("bar")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var (
+				got     []error
+				indexes []int
+			)
+			for i, err := range All(tt.give) {
+				indexes = append(indexes, i)
+				got = append(got, err)
+			}
+			assert.Equal(t, tt.want, got)
+			for i, idx := range indexes {
+				assert.Equal(t, i, idx)
+			}
+		})
+	}
+
+	t.Run("stops early", func(t *testing.T) {
+		err := Combine(errors.New("foo"), errors.New("bar"), errors.New("baz"))
+		var got []error
+		for _, e := range All(err) {
+			got = append(got, e)
+			break
+		}
+		assert.Equal(t, []error{errors.New("foo")}, got)
+	})
+}
+
+func TestAllDoesNotAllocate(t *testing.T) {
+	err := Combine(errors.New("foo"), errors.New("bar"), errors.New("baz"))
+	allocs := testing.AllocsPerRun(100, func() {
+		for range All(err) {
+		}
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func TestCombineSeq(t *testing.T) {
+	tests := []struct {
+		desc string
+		give []error
+		want error
+	}{
+		{desc: "empty"},
+		{
+			desc: "all nil",
+			give: []error{nil, nil},
+		},
+		{
+			desc: "single error",
+			give: []error{nil, errors.New("foo"), nil},
+			want: errors.New("foo"),
+		},
+		{
+			desc: "multiple errors",
+			give: []error{errors.New("foo"), nil, errors.New("bar")},
+			want: newMultiErr(errors.New("foo"), errors.New("bar")),
+		},
+		{
+			desc: "flattens nested",
+			give: []error{
+				Combine(errors.New("foo"), errors.New("bar")),
+				errors.New("baz"),
+			},
+			want: newMultiErr(
+				errors.New("foo"),
+				errors.New("bar"),
+				errors.New("baz"),
+			),
+		},
+		{
+			desc: "single multiError",
+			give: []error{nil, Combine(errors.New("foo"), errors.New("bar"))},
+			want: newMultiErr(errors.New("foo"), errors.New("bar")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := CombineSeq(slices.Values(tt.give))
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, Combine(tt.give...), got)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// MapSlice calls fn on every item of the given slice and collects both the
+// results and the failures.
+//
+//	users, err := multierr.MapSlice(ids, fetchUser)
+//
+// The returned slice is aligned with items: it holds the result for
+// items[i] at index i. Failed calls leave the zero value of R at their
+// index.
+//
+// The returned error combines the failures of all calls, or is nil if every
+// call succeeded. Each failure is keyed with the index of the item that
+// caused it. Use [Index] to retrieve it.
+//
+//	for _, err := range multierr.Errors(err) {
+//		i, _ := multierr.Index(err)
+//		log.Printf("item %v failed: %v", ids[i], err)
+//	}
+func MapSlice[T, R any](items []T, fn func(T) (R, error)) ([]R, error) {
+	results := make([]R, len(items))
+	var err error
+	for i, item := range items {
+		r, ferr := fn(item)
+		if AppendInto(&err, keyed(i, ferr)) {
+			continue
+		}
+		results[i] = r
+	}
+	return results, err
+}
+
+// MapSliceParallel is a variant of [MapSlice] that runs fn concurrently,
+// with at most limit calls in flight at any given time. If limit is zero or
+// negative, all calls are started at once.
+//
+//	users, err := multierr.MapSliceParallel(ids, 8, fetchUser)
+//
+// As with MapSlice, results are aligned with items, and failures are
+// reported in the order of the items that produced them regardless of the
+// order in which calls finished.
+func MapSliceParallel[T, R any](items []T, limit int, fn func(T) (R, error)) ([]R, error) {
+	if limit <= 0 || limit > len(items) {
+		limit = len(items)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, limit)
+		results = make([]R, len(items))
+		errs    = make([]error, len(items))
+	)
+	for i, item := range items {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, item T) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i], errs[i] = fn(item)
+		}(i, item)
+	}
+	wg.Wait()
+
+	var (
+		err  error
+		zero R
+	)
+	for i := range results {
+		if AppendInto(&err, keyed(i, errs[i])) {
+			results[i] = zero
+		}
+	}
+	return results, err
+}
+
+// keyed attaches the given index to err. It returns nil if err is nil.
+func keyed(i int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &indexError{index: i, err: err}
+}
+
+// indexError is an error keyed with the index of the item that caused it.
+type indexError struct {
+	index int
+	err   error
+}
+
+func (e *indexError) Error() string {
+	return "[" + strconv.Itoa(e.index) + "]: " + e.err.Error()
+}
+
+// Unwrap returns the error that was keyed.
+func (e *indexError) Unwrap() error {
+	return e.err
+}
+
+func (e *indexError) Format(f fmt.State, c rune) {
+	io.WriteString(f, "["+strconv.Itoa(e.index)+"]: ")
+	if c == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, "%+v", e.err)
+	} else {
+		io.WriteString(f, e.err.Error())
+	}
+}
+
+// Index reports the index of the item that caused err, as recorded by
+// [MapSlice] or [MapSliceParallel]. It returns false if err was not keyed
+// with an index.
+func Index(err error) (int, bool) {
+	var ierr *indexError
+	if errors.As(err, &ierr) {
+		return ierr.index, true
+	}
+	return 0, false
+}
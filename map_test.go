@@ -0,0 +1,176 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapSlice(t *testing.T) {
+	mappers := []struct {
+		name string
+		fn   func([]string, func(string) (int, error)) ([]int, error)
+	}{
+		{name: "serial", fn: MapSlice[string, int]},
+		{
+			name: "parallel",
+			fn: func(items []string, fn func(string) (int, error)) ([]int, error) {
+				return MapSliceParallel(items, 2, fn)
+			},
+		},
+		{
+			name: "parallel unlimited",
+			fn: func(items []string, fn func(string) (int, error)) ([]int, error) {
+				return MapSliceParallel(items, 0, fn)
+			},
+		},
+	}
+
+	tests := []struct {
+		desc        string
+		give        []string
+		want        []int
+		wantIndexes []int
+	}{
+		{
+			desc: "empty",
+			want: []int{},
+		},
+		{
+			desc: "no errors",
+			give: []string{"1", "2", "3"},
+			want: []int{1, 2, 3},
+		},
+		{
+			desc:        "single error",
+			give:        []string{"1", "x", "3"},
+			want:        []int{1, 0, 3},
+			wantIndexes: []int{1},
+		},
+		{
+			desc:        "multiple errors",
+			give:        []string{"a", "2", "b", "4", "c"},
+			want:        []int{0, 2, 0, 4, 0},
+			wantIndexes: []int{0, 2, 4},
+		},
+		{
+			desc:        "all errors",
+			give:        []string{"a", "b"},
+			want:        []int{0, 0},
+			wantIndexes: []int{0, 1},
+		},
+	}
+
+	for _, m := range mappers {
+		t.Run(m.name, func(t *testing.T) {
+			for _, tt := range tests {
+				t.Run(tt.desc, func(t *testing.T) {
+					got, err := m.fn(tt.give, strconv.Atoi)
+					assert.Equal(t, tt.want, got)
+
+					if len(tt.wantIndexes) == 0 {
+						assert.NoError(t, err)
+						return
+					}
+
+					errs := Errors(err)
+					require.Len(t, errs, len(tt.wantIndexes))
+					for i, e := range errs {
+						idx, ok := Index(e)
+						require.True(t, ok, "error %v must be keyed", e)
+						assert.Equal(t, tt.wantIndexes[i], idx)
+
+						var numErr *strconv.NumError
+						assert.ErrorAs(t, e, &numErr)
+						assert.Equal(t, tt.give[idx], numErr.Num)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestMapSliceZeroesFailedResults(t *testing.T) {
+	fn := func(i int) (int, error) {
+		if i == 2 {
+			return -1, errors.New("great sadness")
+		}
+		return i * 10, nil
+	}
+
+	got, err := MapSlice([]int{1, 2, 3}, fn)
+	assert.Equal(t, []int{10, 0, 30}, got)
+	assert.EqualError(t, err, "[1]: great sadness")
+
+	got, err = MapSliceParallel([]int{1, 2, 3}, 0, fn)
+	assert.Equal(t, []int{10, 0, 30}, got)
+	assert.EqualError(t, err, "[1]: great sadness")
+}
+
+func TestMapSliceParallelLimit(t *testing.T) {
+	const limit = 3
+
+	var running, peak atomic.Int32
+	items := make([]int, 20)
+	_, err := MapSliceParallel(items, limit, func(int) (struct{}, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return struct{}{}, nil
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, peak.Load(), int32(limit))
+}
+
+func TestIndexError(t *testing.T) {
+	err := keyed(3, richFormatError{})
+	assert.Equal(t, "[3]: without plus", err.Error())
+	assert.Equal(t, "[3]: without plus", fmt.Sprintf("%v", err))
+	assert.Equal(t, "[3]: multiline\nmessage\nwith plus", fmt.Sprintf("%+v", err))
+
+	assert.Nil(t, keyed(3, nil))
+
+	t.Run("Index", func(t *testing.T) {
+		_, ok := Index(nil)
+		assert.False(t, ok)
+
+		_, ok = Index(errors.New("great sadness"))
+		assert.False(t, ok)
+
+		i, ok := Index(fmt.Errorf("wrapped: %w", keyed(5, errors.New("great sadness"))))
+		assert.True(t, ok)
+		assert.Equal(t, 5, i)
+	})
+}
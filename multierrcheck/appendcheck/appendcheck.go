@@ -0,0 +1,305 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package appendcheck defines an Analyzer that reports misuse of the
+// multierr append functions.
+//
+// # Analyzer multierrappend
+//
+// multierrappend: report misuse of multierr append functions
+//
+// The analyzer reports the following mistakes.
+//
+// Deferring AppendInto with a function call as the error argument. Go
+// evaluates the arguments of a deferred call immediately, so the function
+// is called when the defer statement runs rather than when the surrounding
+// function returns.
+//
+//	defer multierr.AppendInto(&err, f.Close()) // f.Close is called right away
+//
+// The suggested fix is to use AppendFunc.
+//
+//	defer multierr.AppendFunc(&err, f.Close)
+//
+// Appending into an error from a deferred call when that error is not a
+// named result of the surrounding function. The appended errors are lost
+// because the function has already decided what to return.
+//
+//	func process() error {
+//		var err error
+//		defer multierr.AppendInvoke(&err, multierr.Close(f)) // lost
+//		...
+//		return err
+//	}
+//
+// Discarding the result of Append or Combine. These functions do not modify
+// their arguments.
+//
+//	multierr.Append(err, f.Close()) // should be err = multierr.Append(...)
+package appendcheck // import "go.uber.org/multierr/multierrcheck/appendcheck"
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"go.uber.org/multierr/multierrcheck/internal/astutil"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// Analyzer reports misuse of the multierr append functions.
+var Analyzer = &analysis.Analyzer{
+	Name:     "multierrappend",
+	Doc:      "report misuse of multierr append functions",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// _multierrPath is the import path of the multierr package.
+const _multierrPath = "go.uber.org/multierr"
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.DeferStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+	insp.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		switch n := n.(type) {
+		case *ast.DeferStmt:
+			checkDefer(pass, n, astutil.EnclosingFunc(stack))
+		case *ast.ExprStmt:
+			checkDiscarded(pass, n)
+		}
+		return true
+	})
+	return nil, nil
+}
+
+// checkDefer inspects a defer statement inside the given function.
+func checkDefer(pass *analysis.Pass, stmt *ast.DeferStmt, fn ast.Node) {
+	call := stmt.Call
+	if lit, ok := ast.Unparen(call.Fun).(*ast.FuncLit); ok {
+		checkDeferredFuncLit(pass, lit, fn)
+		return
+	}
+
+	switch name := funcName(pass.TypesInfo, call); name {
+	case "AppendInto":
+		if len(call.Args) == 2 {
+			checkEagerCall(pass, call)
+		}
+		fallthrough
+	case "AppendInvoke", "AppendFunc":
+		if len(call.Args) > 0 {
+			checkTarget(pass, call.Args[0], fn, nil)
+		}
+	case "Append", "Combine":
+		pass.Reportf(call.Pos(), "result of deferred multierr.%s is discarded", name)
+	}
+}
+
+// checkDeferredFuncLit inspects the body of a function literal invoked by a
+// defer statement for appends into errors that are not named results.
+func checkDeferredFuncLit(pass *analysis.Pass, lit *ast.FuncLit, fn ast.Node) {
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Nested function literals may run at any time.
+			return false
+
+		case *ast.CallExpr:
+			switch funcName(pass.TypesInfo, n) {
+			case "AppendInto", "AppendInvoke", "AppendFunc":
+				if len(n.Args) > 0 {
+					checkTarget(pass, n.Args[0], fn, lit)
+				}
+			}
+
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return true
+			}
+			call, ok := ast.Unparen(n.Rhs[0]).(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			switch funcName(pass.TypesInfo, call) {
+			case "Append", "Combine":
+				checkTarget(pass, n.Lhs[0], fn, lit)
+			}
+		}
+		return true
+	})
+}
+
+// checkEagerCall reports deferred AppendInto calls whose error argument is a
+// function call, and suggests using AppendFunc instead.
+func checkEagerCall(pass *analysis.Pass, call *ast.CallExpr) {
+	arg, ok := ast.Unparen(call.Args[1]).(*ast.CallExpr)
+	if !ok || isConversion(pass.TypesInfo, arg) {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos: arg.Pos(),
+		End: arg.End(),
+		Message: "deferred multierr.AppendInto evaluates " + astutil.Render(pass.Fset, arg) +
+			" immediately; use multierr.AppendFunc to call it when the function returns",
+	}
+
+	// We can only defer the call if it has no arguments. Otherwise, the
+	// arguments would be evaluated at a different time.
+	if funName := calleeIdent(call); funName != nil && len(arg.Args) == 0 && !arg.Ellipsis.IsValid() {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: "Use multierr.AppendFunc",
+			TextEdits: []analysis.TextEdit{
+				{Pos: funName.Pos(), End: funName.End(), NewText: []byte("AppendFunc")},
+				{Pos: arg.Pos(), End: arg.End(), NewText: []byte(astutil.Render(pass.Fset, arg.Fun))},
+			},
+		}}
+	}
+	pass.Report(diag)
+}
+
+// checkTarget reports if the given expression, which is the destination of
+// an append from a deferred call, is not a named result of fn.
+//
+// expr is either an identifier, or the address of one.
+//
+// Variables declared inside lit, the deferred function literal if any, are
+// local to the deferred call and are not reported.
+func checkTarget(pass *analysis.Pass, expr ast.Expr, fn ast.Node, lit *ast.FuncLit) {
+	expr = ast.Unparen(expr)
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = ast.Unparen(u.X)
+	}
+	id, ok := expr.(*ast.Ident)
+	if !ok || fn == nil {
+		return
+	}
+
+	v, ok := pass.TypesInfo.ObjectOf(id).(*types.Var)
+	if !ok || v.Pos() < fn.Pos() || v.Pos() >= fn.End() {
+		// Variables declared outside the function outlive the return.
+		return
+	}
+	if lit != nil && v.Pos() >= lit.Pos() && v.Pos() < lit.End() {
+		// The deferred function uses the error itself.
+		return
+	}
+
+	if isNamedResult(pass.TypesInfo, fn, v) {
+		return
+	}
+
+	pass.Reportf(id.Pos(), "%s is not a named result: "+
+		"errors appended to it from a deferred call are not returned", id.Name)
+}
+
+// checkDiscarded reports calls to Append or Combine whose result is unused.
+func checkDiscarded(pass *analysis.Pass, stmt *ast.ExprStmt) {
+	call, ok := ast.Unparen(stmt.X).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	name := funcName(pass.TypesInfo, call)
+	if name != "Append" && name != "Combine" {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: "result of multierr." + name + " is discarded",
+	}
+
+	if name == "Append" && len(call.Args) == 2 {
+		if id, ok := ast.Unparen(call.Args[0]).(*ast.Ident); ok {
+			if _, ok := pass.TypesInfo.ObjectOf(id).(*types.Var); ok {
+				diag.SuggestedFixes = []analysis.SuggestedFix{{
+					Message: "Assign the result to " + id.Name,
+					TextEdits: []analysis.TextEdit{{
+						Pos:     stmt.Pos(),
+						End:     stmt.Pos(),
+						NewText: []byte(id.Name + " = "),
+					}},
+				}}
+			}
+		}
+	}
+	pass.Report(diag)
+}
+
+// funcName returns the name of the top-level multierr function called by
+// the given call expression, or an empty string if it doesn't call one.
+func funcName(info *types.Info, call *ast.CallExpr) string {
+	fn, ok := typeutil.Callee(info, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != _multierrPath {
+		return ""
+	}
+	if sig, ok := fn.Type().(*types.Signature); !ok || sig.Recv() != nil {
+		return ""
+	}
+	return fn.Name()
+}
+
+// isNamedResult reports whether v is one of the results of the given
+// function declaration or literal.
+func isNamedResult(info *types.Info, fn ast.Node, v *types.Var) bool {
+	sig := astutil.Signature(info, fn)
+	if sig == nil {
+		return false
+	}
+
+	results := sig.Results()
+	for i := 0; i < results.Len(); i++ {
+		if results.At(i) == v {
+			return true
+		}
+	}
+	return false
+}
+
+// calleeIdent returns the identifier naming the function called by call.
+func calleeIdent(call *ast.CallExpr) *ast.Ident {
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		return fun
+	case *ast.SelectorExpr:
+		return fun.Sel
+	}
+	return nil
+}
+
+// isConversion reports whether call is a type conversion.
+func isConversion(info *types.Info, call *ast.CallExpr) bool {
+	tv, ok := info.Types[call.Fun]
+	return ok && tv.IsType()
+}
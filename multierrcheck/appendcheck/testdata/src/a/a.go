@@ -0,0 +1,102 @@
+package a
+
+import (
+	"errors"
+	"io"
+
+	"go.uber.org/multierr"
+)
+
+func eager(c io.Closer) (err error) {
+	defer multierr.AppendInto(&err, c.Close()) // want `deferred multierr.AppendInto evaluates c.Close\(\) immediately`
+	return nil
+}
+
+func eagerWithArgs(f func(int) error) (err error) {
+	defer multierr.AppendInto(&err, f(42)) // want `deferred multierr.AppendInto evaluates f\(42\) immediately`
+	return nil
+}
+
+func eagerConversion(e myError) (err error) {
+	defer multierr.AppendInto(&err, error(e))
+	return nil
+}
+
+func notDeferred(c io.Closer) (err error) {
+	multierr.AppendInto(&err, c.Close())
+	return err
+}
+
+func lazy(c io.Closer) (err error) {
+	defer multierr.AppendInvoke(&err, multierr.Close(c))
+	defer multierr.AppendFunc(&err, c.Close)
+	defer func() {
+		multierr.AppendInto(&err, c.Close())
+	}()
+	defer func() {
+		err = multierr.Append(err, c.Close())
+	}()
+	return nil
+}
+
+func unnamed(c io.Closer) error {
+	var err error
+	defer multierr.AppendInvoke(&err, multierr.Close(c)) // want `err is not a named result`
+	defer multierr.AppendFunc(&err, c.Close)             // want `err is not a named result`
+	defer func() {
+		err = multierr.Append(err, c.Close()) // want `err is not a named result`
+	}()
+	defer func() {
+		multierr.AppendInto(&err, c.Close()) // want `err is not a named result`
+	}()
+	return err
+}
+
+func param(c io.Closer, err error) error {
+	defer multierr.AppendInvoke(&err, multierr.Close(c)) // want `err is not a named result`
+	return err
+}
+
+var globalErr error
+
+func global(c io.Closer) {
+	defer multierr.AppendInvoke(&globalErr, multierr.Close(c))
+}
+
+func literal(c io.Closer) {
+	_ = func() (err error) {
+		defer multierr.AppendInvoke(&err, multierr.Close(c))
+		return nil
+	}
+
+	_ = func() error {
+		var err error
+		defer multierr.AppendInvoke(&err, multierr.Close(c)) // want `err is not a named result`
+		return err
+	}
+}
+
+func localToDefer(c io.Closer, log func(error)) {
+	defer func() {
+		var err error
+		multierr.AppendInto(&err, c.Close())
+		err = multierr.Append(err, errors.New("foo"))
+		log(err)
+	}()
+}
+
+func discarded(c io.Closer) error {
+	var err error
+	multierr.Append(err, c.Close())               // want `result of multierr.Append is discarded`
+	multierr.Combine(err, c.Close())              // want `result of multierr.Combine is discarded`
+	defer multierr.Append(err, errors.New("foo")) // want `result of deferred multierr.Append is discarded`
+	err = multierr.Append(err, c.Close())
+
+	var b multierr.Builder
+	b.Add(err)
+	return err
+}
+
+type myError struct{}
+
+func (myError) Error() string { return "my error" }
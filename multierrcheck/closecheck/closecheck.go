@@ -0,0 +1,387 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package closecheck defines an Analyzer that reports deferred Close, Flush
+// and Sync calls whose errors are discarded.
+//
+// # Analyzer multierrclose
+//
+// multierrclose: report deferred Close, Flush and Sync calls that discard errors
+//
+// Writers often report failures to persist data only when they are flushed
+// or closed. Deferring these calls directly discards those errors.
+//
+//	func writeConfig(path string, cfg Config) error {
+//		f, err := os.Create(path)
+//		if err != nil {
+//			return err
+//		}
+//		defer f.Close() // error is discarded
+//		return json.NewEncoder(f).Encode(cfg)
+//	}
+//
+// The analyzer reports deferred calls to Close, Flush and Sync methods that
+// return only an error inside functions that return an error. To limit noise,
+// Close is only reported on values that can be written to: values with a
+// Write, Flush or Sync method.
+//
+// The suggested fix appends the error into the returned error with
+// multierr.AppendInvoke, naming the error result if necessary.
+//
+//	func writeConfig(path string, cfg Config) (err error) {
+//		...
+//		defer multierr.AppendInvoke(&err, multierr.Close(f))
+package closecheck // import "go.uber.org/multierr/multierrcheck/closecheck"
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"go.uber.org/multierr/multierrcheck/internal/astutil"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// Analyzer reports deferred Close, Flush and Sync calls whose errors are
+// discarded.
+var Analyzer = &analysis.Analyzer{
+	Name:     "multierrclose",
+	Doc:      "report deferred Close, Flush and Sync calls that discard errors",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+const (
+	_multierrPath = "go.uber.org/multierr"
+	_errName      = "err"
+)
+
+var _errorType = types.Universe.Lookup("error").Type()
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.File)(nil),
+		(*ast.DeferStmt)(nil),
+	}
+	var file *ast.File
+	insp.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		switch n := n.(type) {
+		case *ast.File:
+			file = n
+		case *ast.DeferStmt:
+			check(pass, file, n, astutil.EnclosingFunc(stack))
+		}
+		return true
+	})
+	return nil, nil
+}
+
+func check(pass *analysis.Pass, file *ast.File, stmt *ast.DeferStmt, fn ast.Node) {
+	sel, ok := ast.Unparen(stmt.Call.Fun).(*ast.SelectorExpr)
+	if !ok || len(stmt.Call.Args) > 0 {
+		return
+	}
+
+	method := sel.Sel.Name
+	switch method {
+	case "Close", "Flush", "Sync":
+	default:
+		return
+	}
+
+	if !returnsOnlyError(pass.TypesInfo.TypeOf(sel)) {
+		return
+	}
+	recv := pass.TypesInfo.TypeOf(sel.X)
+	if recv == nil || (method == "Close" && !isWritable(recv)) {
+		return
+	}
+
+	sig := astutil.Signature(pass.TypesInfo, fn)
+	if sig == nil || sig.Results().Len() == 0 {
+		return
+	}
+	results := sig.Results()
+	if !types.Identical(results.At(results.Len()-1).Type(), _errorType) {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos: stmt.Pos(),
+		End: stmt.End(),
+		Message: "deferred " + astutil.Render(pass.Fset, sel) + " discards its error; " +
+			"use multierr.AppendInvoke to return it",
+	}
+	if edits, ok := fix(pass, file, stmt, sel, fn); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message:   "Append the error with multierr.AppendInvoke",
+			TextEdits: edits,
+		}}
+	}
+	pass.Report(diag)
+}
+
+// fix builds the edits that rewrite the given defer statement to append its
+// error into the error result of fn.
+func fix(pass *analysis.Pass, file *ast.File, stmt *ast.DeferStmt, sel *ast.SelectorExpr, fn ast.Node) ([]analysis.TextEdit, bool) {
+	pkgName, importEdits, ok := importMultierr(file)
+	if !ok {
+		return nil, false
+	}
+
+	errName, resultEdits, ok := errorResult(pass, fn, stmt.Pos())
+	if !ok {
+		return nil, false
+	}
+
+	var invoker string
+	if sel.Sel.Name == "Close" {
+		invoker = pkgName + ".Close(" + astutil.Render(pass.Fset, sel.X) + ")"
+	} else {
+		invoker = pkgName + ".Invoke(" + astutil.Render(pass.Fset, sel) + ")"
+	}
+
+	edits := append(importEdits, resultEdits...)
+	edits = append(edits, analysis.TextEdit{
+		Pos:     stmt.Call.Pos(),
+		End:     stmt.Call.End(),
+		NewText: []byte(pkgName + ".AppendInvoke(&" + errName + ", " + invoker + ")"),
+	})
+	return edits, true
+}
+
+// errorResult returns the name of the error result of fn, and the edits
+// needed to name it if it isn't already named. pos is the position at which
+// the name will be used.
+func errorResult(pass *analysis.Pass, fn ast.Node, pos token.Pos) (string, []analysis.TextEdit, bool) {
+	ftype := astutil.FuncType(fn)
+	fields := ftype.Results
+	last := fields.List[len(fields.List)-1]
+
+	if len(last.Names) > 0 {
+		name := last.Names[len(last.Names)-1]
+		if name.Name != "_" {
+			if !resultVisible(pass, fn, name, pos) {
+				return "", nil, false
+			}
+			return name.Name, nil, true
+		}
+		if !canDeclareErr(pass, fn, pos) {
+			return "", nil, false
+		}
+		return _errName, []analysis.TextEdit{{
+			Pos:     name.Pos(),
+			End:     name.End(),
+			NewText: []byte(_errName),
+		}}, true
+	}
+
+	if !canDeclareErr(pass, fn, pos) {
+		return "", nil, false
+	}
+
+	// Results are unnamed. Name all of them because Go doesn't allow
+	// mixing named and unnamed results.
+	var edits []analysis.TextEdit
+	if !fields.Opening.IsValid() {
+		edits = append(edits, analysis.TextEdit{
+			Pos:     last.Pos(),
+			End:     last.Pos(),
+			NewText: []byte("("),
+		}, analysis.TextEdit{
+			Pos:     last.End(),
+			End:     last.End(),
+			NewText: []byte(")"),
+		})
+	}
+	for _, f := range fields.List[:len(fields.List)-1] {
+		edits = append(edits, analysis.TextEdit{
+			Pos:     f.Pos(),
+			End:     f.Pos(),
+			NewText: []byte("_ "),
+		})
+	}
+	edits = append(edits, analysis.TextEdit{
+		Pos:     last.Pos(),
+		End:     last.Pos(),
+		NewText: []byte(_errName + " "),
+	})
+	return _errName, edits, true
+}
+
+// resultVisible reports whether the named result of fn is visible at pos,
+// that is, it isn't shadowed by another variable with the same name.
+func resultVisible(pass *analysis.Pass, fn ast.Node, name *ast.Ident, pos token.Pos) bool {
+	scope := pass.TypesInfo.Scopes[astutil.FuncType(fn)]
+	if scope == nil {
+		return false
+	}
+
+	inner := scope.Innermost(pos)
+	if inner == nil {
+		return false
+	}
+	_, visible := inner.LookupParent(name.Name, pos)
+	return visible != nil && visible == pass.TypesInfo.Defs[name]
+}
+
+// canDeclareErr reports whether an error result named err can be added to
+// fn without changing what err refers to at pos, or breaking the body of
+// the function.
+func canDeclareErr(pass *analysis.Pass, fn ast.Node, pos token.Pos) bool {
+	scope := pass.TypesInfo.Scopes[astutil.FuncType(fn)]
+	if scope == nil {
+		return false
+	}
+
+	inner := scope.Innermost(pos)
+	if inner == nil {
+		return false
+	}
+	_, visible := inner.LookupParent(_errName, pos)
+
+	obj := scope.Lookup(_errName)
+	if obj == nil {
+		// err must not be visible at all, or the new result would
+		// shadow it.
+		return visible == nil
+	}
+	if visible != obj {
+		// err is shadowed at pos.
+		return false
+	}
+
+	// err is declared at the top level of the function. Naming the result
+	// err is safe only if it's an error declared with := alongside other
+	// new variables, as in "f, err := os.Create(path)". That statement
+	// then assigns to the result instead.
+	v, ok := obj.(*types.Var)
+	if !ok || !types.Identical(v.Type(), _errorType) {
+		return false
+	}
+
+	safe := false
+	ast.Inspect(fn, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.DEFINE {
+			return !safe
+		}
+
+		var declaresErr, declaresOther bool
+		for _, lhs := range assign.Lhs {
+			id, ok := lhs.(*ast.Ident)
+			if !ok || pass.TypesInfo.Defs[id] == nil {
+				continue
+			}
+			if pass.TypesInfo.Defs[id] == obj {
+				declaresErr = true
+			} else {
+				declaresOther = true
+			}
+		}
+		if declaresErr {
+			safe = declaresOther
+		}
+		return !safe
+	})
+	return safe
+}
+
+// importMultierr returns the name under which multierr is imported by the
+// given file, and the edits needed to import it if it isn't imported yet.
+func importMultierr(file *ast.File) (string, []analysis.TextEdit, bool) {
+	for _, spec := range file.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err != nil || path != _multierrPath {
+			continue
+		}
+		if spec.Name == nil {
+			return "multierr", nil, true
+		}
+		if spec.Name.Name == "_" || spec.Name.Name == "." {
+			return "", nil, false
+		}
+		return spec.Name.Name, nil, true
+	}
+
+	if file.Scope != nil && file.Scope.Lookup("multierr") != nil {
+		// Something else is already named multierr in this file.
+		return "", nil, false
+	}
+
+	importPath := strconv.Quote(_multierrPath)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		if gen.Lparen.IsValid() {
+			return "multierr", []analysis.TextEdit{{
+				Pos:     gen.Rparen,
+				End:     gen.Rparen,
+				NewText: []byte("\t" + importPath + "\n"),
+			}}, true
+		}
+		return "multierr", []analysis.TextEdit{{
+			Pos:     gen.End(),
+			End:     gen.End(),
+			NewText: []byte("\nimport " + importPath),
+		}}, true
+	}
+
+	return "multierr", []analysis.TextEdit{{
+		Pos:     file.Name.End(),
+		End:     file.Name.End(),
+		NewText: []byte("\n\nimport " + importPath),
+	}}, true
+}
+
+// returnsOnlyError reports whether t is the type of a function that returns
+// only an error.
+func returnsOnlyError(t types.Type) bool {
+	sig, ok := t.(*types.Signature)
+	if !ok || sig.Results().Len() != 1 {
+		return false
+	}
+	return types.Identical(sig.Results().At(0).Type(), _errorType)
+}
+
+// isWritable reports whether values of type t can be written to.
+func isWritable(t types.Type) bool {
+	mset := types.NewMethodSet(t)
+	if _, isPtr := t.Underlying().(*types.Pointer); !isPtr && !types.IsInterface(t) {
+		// Include methods with pointer receivers for addressable values.
+		mset = types.NewMethodSet(types.NewPointer(t))
+	}
+	for _, name := range []string{"Write", "Flush", "Sync"} {
+		if mset.Lookup(nil, name) != nil {
+			return true
+		}
+	}
+	return false
+}
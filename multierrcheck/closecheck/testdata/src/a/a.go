@@ -0,0 +1,80 @@
+package a
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	"go.uber.org/multierr"
+)
+
+func unnamed(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // want `deferred f.Close discards its error`
+	_, err = f.WriteString("hello")
+	return err
+}
+
+func named(path string) (n int, err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Sync() // want `deferred f.Sync discards its error`
+	return f.WriteString("hello")
+}
+
+func multipleResults(w io.Writer) (int, error) {
+	bw := bufio.NewWriter(w)
+	defer bw.Flush() // want `deferred bw.Flush discards its error`
+	return bw.WriteString("hello")
+}
+
+func alreadyImported(w io.WriteCloser) error {
+	defer w.Close() // want `deferred w.Close discards its error`
+	return multierr.Combine()
+}
+
+func varDeclared(w io.WriteCloser) error {
+	var err error
+	defer w.Close() // want `deferred w.Close discards its error`
+	return err
+}
+
+func readOnly(r io.ReadCloser) error {
+	defer r.Close()
+	_, err := io.ReadAll(r)
+	return err
+}
+
+func noErrorResult(w io.WriteCloser) int {
+	defer w.Close()
+	return 0
+}
+
+func handled(w io.WriteCloser) (err error) {
+	defer multierr.AppendInvoke(&err, multierr.Close(w))
+	return nil
+}
+
+func literal(w io.WriteCloser) {
+	_ = func() error {
+		defer w.Close() // want `deferred w.Close discards its error`
+		return nil
+	}
+}
+
+func shadowedResult(path string, create bool) (err error) {
+	if create {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close() // want `deferred f.Close discards its error`
+		_, err = f.WriteString("hello")
+	}
+	return err
+}
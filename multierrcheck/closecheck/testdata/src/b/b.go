@@ -0,0 +1,15 @@
+package b
+
+import (
+	"os"
+)
+
+func write(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // want `deferred f.Close discards its error`
+	_, err = f.WriteString("hello")
+	return err
+}
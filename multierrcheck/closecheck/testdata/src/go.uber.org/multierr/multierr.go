@@ -0,0 +1,26 @@
+// Package multierr is a stub of go.uber.org/multierr for tests.
+package multierr
+
+import "io"
+
+func Append(left, right error) error { return nil }
+
+func Combine(errs ...error) error { return nil }
+
+func AppendInto(into *error, err error) bool { return false }
+
+type Invoker interface{ Invoke() error }
+
+type Invoke func() error
+
+func (i Invoke) Invoke() error { return i() }
+
+func Close(c io.Closer) Invoker { return Invoke(c.Close) }
+
+func AppendInvoke(into *error, invoker Invoker) {}
+
+func AppendFunc(into *error, fn func() error) {}
+
+type Builder struct{}
+
+func (*Builder) Add(err error) bool { return false }
@@ -0,0 +1,227 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierrtest
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/multierr"
+)
+
+var (
+	_ io.Closer        = (*Closer)(nil)
+	_ multierr.Invoker = (*Invoker)(nil)
+)
+
+// Recorder tracks calls made to the Closers and Invokers built from it.
+// Use it to verify that deferred cleanups ran, and in which order.
+//
+//	rec := multierrtest.NewRecorder()
+//	f := rec.Closer("file", multierrtest.Returns(os.ErrClosed))
+//	conn := rec.Closer("conn")
+//
+//	err := process(f, conn)
+//
+//	rec.AssertCalledOnce(t)
+//	rec.AssertOrder(t, "conn", "file")
+//	multierrtest.AssertContains(t, err, os.ErrClosed)
+//
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	doubles []*double
+	calls   []string // names of doubles in the order they were called
+}
+
+// NewRecorder builds a new Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Option customizes the behavior of a Closer or Invoker.
+type Option interface {
+	apply(*double)
+}
+
+type optionFunc func(*double)
+
+func (f optionFunc) apply(d *double) { f(d) }
+
+// Returns scripts the errors returned by a Closer or Invoker. The first call
+// returns the first error, the second call returns the second error, and so
+// on. Calls past the end of the list return the last error.
+//
+//	rec.Closer("file", multierrtest.Returns(nil, os.ErrClosed))
+func Returns(errs ...error) Option {
+	return optionFunc(func(d *double) {
+		d.results = errs
+	})
+}
+
+// Panics makes a Closer or Invoker panic with the given value when called.
+// The call is recorded before panicking.
+func Panics(v interface{}) Option {
+	return optionFunc(func(d *double) {
+		d.panics = true
+		d.panicValue = v
+	})
+}
+
+// Closer builds a new io.Closer registered with this Recorder under the
+// given name. It succeeds unless configured otherwise with [Returns] or
+// [Panics].
+func (r *Recorder) Closer(name string, opts ...Option) *Closer {
+	return &Closer{d: r.newDouble(name, opts)}
+}
+
+// Invoker builds a new multierr.Invoker registered with this Recorder under
+// the given name. It succeeds unless configured otherwise with [Returns] or
+// [Panics].
+func (r *Recorder) Invoker(name string, opts ...Option) *Invoker {
+	return &Invoker{d: r.newDouble(name, opts)}
+}
+
+func (r *Recorder) newDouble(name string, opts []Option) *double {
+	d := &double{rec: r, name: name}
+	for _, opt := range opts {
+		opt.apply(d)
+	}
+
+	r.mu.Lock()
+	r.doubles = append(r.doubles, d)
+	r.mu.Unlock()
+	return d
+}
+
+// Calls returns the names of the Closers and Invokers of this Recorder in
+// the order in which they were called. Names appear once for every call.
+func (r *Recorder) Calls() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.calls...)
+}
+
+// AssertCalledOnce checks that every Closer and Invoker of this Recorder was
+// called exactly once.
+func (r *Recorder) AssertCalledOnce(t testing.TB) bool {
+	t.Helper()
+
+	r.mu.Lock()
+	var failed []string
+	for _, d := range r.doubles {
+		if d.calls != 1 {
+			failed = append(failed, fmt.Sprintf("\n  %v: called %d times", d.name, d.calls))
+		}
+	}
+	r.mu.Unlock()
+
+	if len(failed) == 0 {
+		return true
+	}
+	t.Errorf("cleanups were not called exactly once:%s", strings.Join(failed, ""))
+	return false
+}
+
+// AssertOrder checks that the Closers and Invokers of this Recorder were
+// called in exactly the given order.
+func (r *Recorder) AssertOrder(t testing.TB, names ...string) bool {
+	t.Helper()
+
+	got := r.Calls()
+	if len(got) == len(names) {
+		same := true
+		for i := range got {
+			if got[i] != names[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return true
+		}
+	}
+
+	t.Errorf("cleanups were called in an unexpected order\nwant: %q\ngot:  %q", names, got)
+	return false
+}
+
+// Closer is a scripted io.Closer built by [Recorder.Closer].
+type Closer struct{ d *double }
+
+// Close records the call, and returns the next scripted error.
+func (c *Closer) Close() error { return c.d.call() }
+
+// Calls reports how many times Close was called.
+func (c *Closer) Calls() int { return c.d.callCount() }
+
+// Invoker is a scripted multierr.Invoker built by [Recorder.Invoker].
+type Invoker struct{ d *double }
+
+// Invoke records the call, and returns the next scripted error.
+func (i *Invoker) Invoke() error { return i.d.call() }
+
+// Calls reports how many times Invoke was called.
+func (i *Invoker) Calls() int { return i.d.callCount() }
+
+// double holds the state shared by Closer and Invoker.
+type double struct {
+	rec  *Recorder
+	name string
+
+	results    []error
+	panics     bool
+	panicValue interface{}
+
+	calls int // guarded by rec.mu
+}
+
+func (d *double) call() error {
+	d.rec.mu.Lock()
+	n := d.calls
+	d.calls++
+	d.rec.calls = append(d.rec.calls, d.name)
+	d.rec.mu.Unlock()
+
+	if d.panics {
+		panic(d.panicValue)
+	}
+
+	switch {
+	case len(d.results) == 0:
+		return nil
+	case n < len(d.results):
+		return d.results[n]
+	default:
+		return d.results[len(d.results)-1]
+	}
+}
+
+func (d *double) callCount() int {
+	d.rec.mu.Lock()
+	defer d.rec.mu.Unlock()
+
+	return d.calls
+}
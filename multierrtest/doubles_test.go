@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierrtest
+
+import (
+	"io"
+	"os"
+	"sync"
+	"testing"
+
+	"go.uber.org/multierr"
+)
+
+func TestRecorder(t *testing.T) {
+	rec := NewRecorder()
+	file := rec.Closer("file", Returns(os.ErrClosed))
+	conn := rec.Closer("conn")
+	flush := rec.Invoker("flush", Returns(io.ErrShortWrite))
+
+	err := func() (err error) {
+		defer multierr.AppendInvoke(&err, multierr.Close(file))
+		defer multierr.AppendInvoke(&err, multierr.Close(conn))
+		defer multierr.AppendInvoke(&err, flush)
+		return nil
+	}()
+
+	AssertExactly(t, err, io.ErrShortWrite, os.ErrClosed)
+	rec.AssertCalledOnce(t)
+	rec.AssertOrder(t, "flush", "conn", "file")
+
+	if got := file.Calls(); got != 1 {
+		t.Errorf("file: expected 1 call, got %d", got)
+	}
+	if got := flush.Calls(); got != 1 {
+		t.Errorf("flush: expected 1 call, got %d", got)
+	}
+}
+
+func TestRecorderReturns(t *testing.T) {
+	rec := NewRecorder()
+	c := rec.Closer("c", Returns(nil, io.EOF, os.ErrClosed))
+
+	want := []error{nil, io.EOF, os.ErrClosed, os.ErrClosed}
+	for i, w := range want {
+		if got := c.Close(); got != w {
+			t.Errorf("call %d: want %v, got %v", i, w, got)
+		}
+	}
+	if got := c.Calls(); got != len(want) {
+		t.Errorf("expected %d calls, got %d", len(want), got)
+	}
+}
+
+func TestRecorderPanics(t *testing.T) {
+	rec := NewRecorder()
+	i := rec.Invoker("i", Panics("great sadness"))
+
+	func() {
+		defer func() {
+			if r := recover(); r != "great sadness" {
+				t.Errorf("unexpected panic value: %v", r)
+			}
+		}()
+		i.Invoke()
+	}()
+
+	if got := i.Calls(); got != 1 {
+		t.Errorf("expected panicking call to be recorded, got %d calls", got)
+	}
+}
+
+func TestRecorderAssertCalledOnce(t *testing.T) {
+	rec := NewRecorder()
+	a := rec.Closer("a")
+	rec.Closer("b")
+	c := rec.Invoker("c")
+
+	a.Close()
+	c.Invoke()
+	c.Invoke()
+
+	ok, ft := run(rec.AssertCalledOnce)
+	checkResult(t, ok, ft, false, "cleanups were not called exactly once:\n"+
+		"  b: called 0 times\n"+
+		"  c: called 2 times")
+}
+
+func TestRecorderAssertOrder(t *testing.T) {
+	rec := NewRecorder()
+	a := rec.Closer("a")
+	b := rec.Closer("b")
+	b.Close()
+	a.Close()
+
+	t.Run("success", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return rec.AssertOrder(t, "b", "a")
+		})
+		checkResult(t, ok, ft, true, "")
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return rec.AssertOrder(t, "a", "b")
+		})
+		checkResult(t, ok, ft, false, "cleanups were called in an unexpected order\n"+
+			`want: ["a" "b"]`+"\n"+
+			`got:  ["b" "a"]`)
+	})
+}
+
+func TestRecorderConcurrent(t *testing.T) {
+	rec := NewRecorder()
+	closers := make([]*Closer, 10)
+	for i := range closers {
+		closers[i] = rec.Closer("c")
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range closers {
+		wg.Add(1)
+		go func(c *Closer) {
+			defer wg.Done()
+			c.Close()
+		}(c)
+	}
+	wg.Wait()
+
+	rec.AssertCalledOnce(t)
+	if got := len(rec.Calls()); got != len(closers) {
+		t.Errorf("expected %d calls, got %d", len(closers), got)
+	}
+}
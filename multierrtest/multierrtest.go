@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package multierrtest provides test assertions for errors built with
+// multierr.
+//
+// Assertions report failures through the provided testing.TB and list the
+// individual errors that the checked error is composed of, one per line.
+//
+//	func TestCloseAll(t *testing.T) {
+//		err := closeAll(resources)
+//		multierrtest.AssertCount(t, err, 2)
+//		multierrtest.AssertContains(t, err, os.ErrClosed)
+//	}
+//
+// For code that defers cleanups with multierr.AppendInvoke, use a [Recorder]
+// to build Closers and Invokers that return scripted errors and track
+// whether they ran.
+//
+// This package depends only on the standard library.
+package multierrtest // import "go.uber.org/multierr/multierrtest"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/multierr"
+)
+
+// UpdateGoldenEnv is the environment variable that, when set to a non-empty
+// value, makes [AssertGolden] write golden files instead of comparing
+// against them.
+//
+//	MULTIERRTEST_UPDATE=1 go test ./...
+const UpdateGoldenEnv = "MULTIERRTEST_UPDATE"
+
+// AssertContains checks that for each of the given targets, err contains at
+// least one error that matches it with errors.Is.
+//
+//	multierrtest.AssertContains(t, err, io.ErrUnexpectedEOF, os.ErrClosed)
+func AssertContains(t testing.TB, err error, targets ...error) bool {
+	t.Helper()
+
+	var missing []error
+	for _, target := range targets {
+		if !containsError(err, target) {
+			missing = append(missing, target)
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+
+	t.Errorf("error does not contain expected errors\nmissing:%v\nerrors:%v",
+		listErrors(missing...), listErrors(multierr.Errors(err)...))
+	return false
+}
+
+// AssertExactly checks that err is composed of exactly the given errors, in
+// any order. Like [AssertContains], an error matches a wanted error if
+// errors.Is reports that it does, so a wrapped sentinel matches the
+// sentinel. Each error inside err may match only one wanted error.
+//
+//	multierrtest.AssertExactly(t, err, io.EOF, os.ErrClosed)
+func AssertExactly(t testing.TB, err error, want ...error) bool {
+	t.Helper()
+
+	got := multierr.Errors(err)
+	missing, extra := matchErrors(got, want)
+	if len(missing) == 0 && len(extra) == 0 {
+		return true
+	}
+
+	t.Errorf("error is not composed of exactly the expected errors\nmissing:%v\nextra:%v\nerrors:%v",
+		listErrors(missing...), listErrors(extra...), listErrors(got...))
+	return false
+}
+
+// AssertEvery checks that every error inside err satisfies the given
+// predicate.
+//
+//	multierrtest.AssertEvery(t, err, func(err error) bool {
+//		return errors.Is(err, context.Canceled)
+//	})
+func AssertEvery(t testing.TB, err error, pred func(error) bool) bool {
+	t.Helper()
+
+	var failed []string
+	for i, e := range multierr.Errors(err) {
+		if !pred(e) {
+			failed = append(failed, fmt.Sprintf("\n  [%d] %v", i, e))
+		}
+	}
+	if len(failed) == 0 {
+		return true
+	}
+
+	t.Errorf("errors do not satisfy the predicate:%s\nerrors:%v",
+		strings.Join(failed, ""), listErrors(multierr.Errors(err)...))
+	return false
+}
+
+// AssertCount checks that err is composed of exactly n errors. A nil error
+// is composed of zero errors.
+func AssertCount(t testing.TB, err error, n int) bool {
+	t.Helper()
+
+	if got := multierr.Len(err); got != n {
+		t.Errorf("expected %d errors, got %d\nerrors:%v", n, got, listErrors(multierr.Errors(err)...))
+		return false
+	}
+	return true
+}
+
+// AssertGolden checks that the multi-line message of err, as produced by
+// %+v, matches the contents of the golden file at the given path.
+//
+//	multierrtest.AssertGolden(t, err, "testdata/close_all.golden")
+//
+// If the environment variable named by [UpdateGoldenEnv] is set, the golden
+// file is written with the message of err instead.
+func AssertGolden(t testing.TB, err error, path string) bool {
+	t.Helper()
+
+	got := fmt.Sprintf("%+v", err)
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("create golden file directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("write golden file: %v", err)
+		}
+		return true
+	}
+
+	want, rerr := os.ReadFile(path)
+	if rerr != nil {
+		t.Fatalf("read golden file: %v (set %v=1 to create it)", rerr, UpdateGoldenEnv)
+		return false
+	}
+	if string(want) == got {
+		return true
+	}
+
+	t.Errorf("error does not match golden file %v (set %v=1 to update it)\nwant:\n%s\ngot:\n%s",
+		path, UpdateGoldenEnv, want, got)
+	return false
+}
+
+func containsError(err, target error) bool {
+	for _, e := range multierr.Errors(err) {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchErrors pairs each wanted error with a distinct error in got that
+// matches it with errors.Is. It returns the wanted errors that couldn't be
+// paired, and the errors in got that were left over.
+func matchErrors(got, want []error) (missing, extra []error) {
+	// owner[i] is the index of the wanted error paired with got[i], or -1.
+	owner := make([]int, len(got))
+	for i := range owner {
+		owner[i] = -1
+	}
+
+	// assign pairs want[w] with an error in got, re-pairing errors that
+	// are already taken if they can match another wanted error instead.
+	var assign func(w int, seen []bool) bool
+	assign = func(w int, seen []bool) bool {
+		for i, g := range got {
+			if seen[i] || !errors.Is(g, want[w]) {
+				continue
+			}
+			seen[i] = true
+			if owner[i] < 0 || assign(owner[i], seen) {
+				owner[i] = w
+				return true
+			}
+		}
+		return false
+	}
+
+	for w, target := range want {
+		if !assign(w, make([]bool, len(got))) {
+			missing = append(missing, target)
+		}
+	}
+	for i, g := range got {
+		if owner[i] < 0 {
+			extra = append(extra, g)
+		}
+	}
+	return missing, extra
+}
+
+// listErrors renders the given errors one per line, along with their
+// positions.
+func listErrors(errs ...error) string {
+	if len(errs) == 0 {
+		return " <none>"
+	}
+
+	var sb strings.Builder
+	for i, err := range errs {
+		fmt.Fprintf(&sb, "\n  [%d] %v", i, err)
+	}
+	return sb.String()
+}
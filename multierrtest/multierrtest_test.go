@@ -0,0 +1,322 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierrtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/multierr"
+)
+
+// fakeT records failures reported to it.
+type fakeT struct {
+	testing.TB
+
+	errors []string
+	fatal  bool
+}
+
+type fatalSentinel struct{}
+
+func (*fakeT) Helper() {}
+
+func (t *fakeT) Errorf(msg string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(msg, args...))
+}
+
+func (t *fakeT) Fatalf(msg string, args ...interface{}) {
+	t.Errorf(msg, args...)
+	t.fatal = true
+	panic(fatalSentinel{})
+}
+
+// run calls f with a fakeT, and returns the result of f along with the
+// fakeT.
+func run(f func(testing.TB) bool) (ok bool, ft *fakeT) {
+	ft = &fakeT{}
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isFatal := r.(fatalSentinel); !isFatal {
+				panic(r)
+			}
+		}
+	}()
+	return f(ft), ft
+}
+
+func checkResult(t *testing.T, ok bool, ft *fakeT, wantOK bool, wantMsg string) {
+	t.Helper()
+
+	if ok != wantOK {
+		t.Errorf("expected result %v, got %v", wantOK, ok)
+	}
+	if wantOK {
+		if len(ft.errors) > 0 {
+			t.Errorf("unexpected failures: %q", ft.errors)
+		}
+		return
+	}
+
+	if len(ft.errors) != 1 {
+		t.Fatalf("expected exactly one failure, got %q", ft.errors)
+	}
+	if ft.errors[0] != wantMsg {
+		t.Errorf("unexpected failure message:\nwant:\n%s\ngot:\n%s", wantMsg, ft.errors[0])
+	}
+}
+
+func TestAssertContains(t *testing.T) {
+	err := multierr.Combine(
+		fmt.Errorf("read: %w", io.ErrUnexpectedEOF),
+		os.ErrClosed,
+	)
+
+	t.Run("success", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertContains(t, err, io.ErrUnexpectedEOF, os.ErrClosed)
+		})
+		checkResult(t, ok, ft, true, "")
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertContains(t, err, io.ErrUnexpectedEOF, io.EOF, context.Canceled)
+		})
+		checkResult(t, ok, ft, false, "error does not contain expected errors\n"+
+			"missing:\n"+
+			"  [0] EOF\n"+
+			"  [1] context canceled\n"+
+			"errors:\n"+
+			"  [0] read: unexpected EOF\n"+
+			"  [1] file already closed")
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertContains(t, nil, io.EOF)
+		})
+		checkResult(t, ok, ft, false, "error does not contain expected errors\n"+
+			"missing:\n"+
+			"  [0] EOF\n"+
+			"errors: <none>")
+	})
+}
+
+func TestAssertExactly(t *testing.T) {
+	err := multierr.Combine(io.EOF, os.ErrClosed)
+
+	t.Run("success", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertExactly(t, err, os.ErrClosed, io.EOF)
+		})
+		checkResult(t, ok, ft, true, "")
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertExactly(t, nil)
+		})
+		checkResult(t, ok, ft, true, "")
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertExactly(t, err, io.EOF, context.Canceled)
+		})
+		checkResult(t, ok, ft, false, "error is not composed of exactly the expected errors\n"+
+			"missing:\n"+
+			"  [0] context canceled\n"+
+			"extra:\n"+
+			"  [0] file already closed\n"+
+			"errors:\n"+
+			"  [0] EOF\n"+
+			"  [1] file already closed")
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertExactly(t, fmt.Errorf("read: %w", io.EOF), io.EOF)
+		})
+		checkResult(t, ok, ft, true, "")
+	})
+
+	t.Run("each error matches once", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertExactly(t, fmt.Errorf("read: %w", io.EOF), io.EOF, io.EOF)
+		})
+		checkResult(t, ok, ft, false, "error is not composed of exactly the expected errors\n"+
+			"missing:\n"+
+			"  [0] EOF\n"+
+			"extra: <none>\n"+
+			"errors:\n"+
+			"  [0] read: EOF")
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		// Both errors match os.ErrClosed, but only the first matches
+		// io.EOF. They must be paired with the wanted errors accordingly.
+		both := multierr.Combine(
+			fmt.Errorf("close: %w", multierr.Combine(os.ErrClosed, io.EOF)),
+			fmt.Errorf("close: %w", os.ErrClosed),
+		)
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertExactly(t, both, os.ErrClosed, io.EOF)
+		})
+		checkResult(t, ok, ft, true, "")
+	})
+}
+
+func TestAssertEvery(t *testing.T) {
+	isTimeout := func(err error) bool {
+		return errors.Is(err, context.DeadlineExceeded)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertEvery(t, multierr.Combine(
+				context.DeadlineExceeded,
+				fmt.Errorf("dial: %w", context.DeadlineExceeded),
+			), isTimeout)
+		})
+		checkResult(t, ok, ft, true, "")
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertEvery(t, multierr.Combine(
+				context.DeadlineExceeded,
+				io.EOF,
+			), isTimeout)
+		})
+		checkResult(t, ok, ft, false, "errors do not satisfy the predicate:\n"+
+			"  [1] EOF\n"+
+			"errors:\n"+
+			"  [0] context deadline exceeded\n"+
+			"  [1] EOF")
+	})
+}
+
+func TestAssertCount(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    error
+		n       int
+		wantOK  bool
+		wantMsg string
+	}{
+		{desc: "nil", n: 0, wantOK: true},
+		{desc: "single", give: io.EOF, n: 1, wantOK: true},
+		{
+			desc:   "multiple",
+			give:   multierr.Combine(io.EOF, os.ErrClosed),
+			n:      2,
+			wantOK: true,
+		},
+		{
+			desc:   "mismatch",
+			give:   multierr.Combine(io.EOF, os.ErrClosed),
+			n:      3,
+			wantOK: false,
+			wantMsg: "expected 3 errors, got 2\n" +
+				"errors:\n" +
+				"  [0] EOF\n" +
+				"  [1] file already closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ok, ft := run(func(t testing.TB) bool {
+				return AssertCount(t, tt.give, tt.n)
+			})
+			checkResult(t, ok, ft, tt.wantOK, tt.wantMsg)
+		})
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	err := multierr.Combine(io.EOF, os.ErrClosed)
+
+	t.Run("match", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertGolden(t, err, "testdata/combined.golden")
+		})
+		checkResult(t, ok, ft, true, "")
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertGolden(t, io.EOF, "testdata/combined.golden")
+		})
+		checkResult(t, ok, ft, false, "error does not match golden file testdata/combined.golden "+
+			"(set MULTIERRTEST_UPDATE=1 to update it)\n"+
+			"want:\n"+
+			"the following errors occurred:\n"+
+			" -  EOF\n"+
+			" -  file already closed\n"+
+			"got:\n"+
+			"EOF")
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing.golden")
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertGolden(t, err, path)
+		})
+		if ok || !ft.fatal {
+			t.Errorf("expected a fatal failure, got %v", ft.errors)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Setenv(UpdateGoldenEnv, "1")
+
+		path := filepath.Join(t.TempDir(), "new", "combined.golden")
+		ok, ft := run(func(t testing.TB) bool {
+			return AssertGolden(t, err, path)
+		})
+		checkResult(t, ok, ft, true, "")
+
+		got, rerr := os.ReadFile(path)
+		if rerr != nil {
+			t.Fatal(rerr)
+		}
+		if want := fmt.Sprintf("%+v", err); string(got) != want {
+			t.Errorf("unexpected golden file contents: %q", got)
+		}
+	})
+}
+
+func TestListErrors(t *testing.T) {
+	got := listErrors(errors.New("foo"), errors.New("bar\nbaz"))
+	if want := "\n  [0] foo\n  [1] bar\nbaz"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := listErrors(), " <none>"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package shutdown coordinates the graceful shutdown of the components of a
+// service, such as servers, consumers and connection pools, and combines
+// their failures with multierr.
+//
+// Components are registered with a multierr.Invoker that stops them, and
+// the phase in which to stop them.
+//
+//	c := shutdown.New(shutdown.Timeout(30 * time.Second))
+//	c.Register(0, "http server", multierr.Invoke(server.Close))
+//	c.Register(0, "kafka consumer", multierr.Close(consumer))
+//	c.Register(1, "database", multierr.Close(db))
+//
+//	sig := make(chan os.Signal, 1)
+//	signal.Notify(sig, syscall.SIGTERM, os.Interrupt)
+//	if err := c.RunOnSignal(sig); err != nil {
+//		log.Fatal(err) // e.g. "kafka consumer: context deadline exceeded"
+//	}
+//
+// Phases run in increasing order. All components of a phase are stopped
+// concurrently, and the next phase starts only once they have all stopped.
+package shutdown // import "go.uber.org/multierr/shutdown"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+)
+
+// Option customizes a [Coordinator].
+type Option interface {
+	applyOption(*Coordinator)
+}
+
+type optionFunc func(*Coordinator)
+
+func (f optionFunc) applyOption(c *Coordinator) { f(c) }
+
+// Timeout limits how long [Coordinator.Run] may take in total. Once it
+// elapses, Run stops waiting for components and reports the ones that did
+// not finish in time.
+func Timeout(d time.Duration) Option {
+	return optionFunc(func(c *Coordinator) {
+		c.timeout = d
+	})
+}
+
+// Coordinator stops the registered components of a service in phases. Build
+// one with [New].
+type Coordinator struct {
+	timeout time.Duration
+
+	mu         sync.Mutex
+	components []component
+
+	once sync.Once
+	err  error
+}
+
+type component struct {
+	phase   int
+	name    string
+	invoker multierr.Invoker
+}
+
+// New builds a new Coordinator with no components.
+func New(opts ...Option) *Coordinator {
+	c := &Coordinator{}
+	for _, opt := range opts {
+		opt.applyOption(c)
+	}
+	return c
+}
+
+// Register adds a component to be stopped during the given phase by calling
+// the provided Invoker. The name identifies the component in the error
+// returned by Run; use multierr.Op to retrieve it.
+//
+// Components registered after Run has started are not stopped.
+func (c *Coordinator) Register(phase int, name string, invoker multierr.Invoker) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.components = append(c.components, component{
+		phase:   phase,
+		name:    name,
+		invoker: invoker,
+	})
+}
+
+// Run stops all registered components, phase by phase, and returns the
+// combined failures of the components that failed, each labeled with the
+// name of its component. A panic inside an Invoker is reported as the
+// failure of its component.
+//
+// If ctx is done or the Timeout elapses before all components have stopped,
+// Run stops waiting. The components that did not finish in time fail with
+// the context's error, and the components of later phases are not stopped;
+// they fail with the same error.
+//
+// Run stops components only once. Later calls return the result of the
+// first call.
+func (c *Coordinator) Run(ctx context.Context) error {
+	c.once.Do(func() {
+		c.err = c.run(ctx)
+	})
+	return c.err
+}
+
+// RunOnSignal waits until a value is received from sig, or sig is closed,
+// and then calls Run. Use signal.Notify to deliver OS signals to sig.
+func (c *Coordinator) RunOnSignal(sig <-chan os.Signal) error {
+	<-sig
+	return c.Run(context.Background())
+}
+
+func (c *Coordinator) run(ctx context.Context) (err error) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	c.mu.Lock()
+	components := append([]component(nil), c.components...)
+	c.mu.Unlock()
+
+	sort.SliceStable(components, func(i, j int) bool {
+		return components[i].phase < components[j].phase
+	})
+
+	for len(components) > 0 {
+		n := 1
+		for n < len(components) && components[n].phase == components[0].phase {
+			n++
+		}
+		err = multierr.Append(err, runPhase(ctx, components[:n]))
+		components = components[n:]
+	}
+	return err
+}
+
+// runPhase stops the given components concurrently and waits until they
+// have all stopped or ctx is done.
+func runPhase(ctx context.Context, components []component) error {
+	type result struct {
+		idx int
+		err error
+	}
+
+	errs := make([]error, len(components))
+	pending := make([]bool, len(components))
+	for i := range pending {
+		pending[i] = true
+	}
+
+	if ctx.Err() == nil {
+		// Buffered so that components that finish after the deadline
+		// don't block forever.
+		results := make(chan result, len(components))
+		for i, c := range components {
+			go func(i int, c component) {
+				results <- result{idx: i, err: c.stop()}
+			}(i, c)
+		}
+
+	wait:
+		for remaining := len(components); remaining > 0; remaining-- {
+			select {
+			case r := <-results:
+				errs[r.idx] = r.err
+				pending[r.idx] = false
+			case <-ctx.Done():
+				// select picks at random if results are ready too.
+				// Don't report components that finished in time.
+				for ; remaining > 0; remaining-- {
+					select {
+					case r := <-results:
+						errs[r.idx] = r.err
+						pending[r.idx] = false
+					default:
+						break wait
+					}
+				}
+			}
+		}
+	}
+
+	for i, c := range components {
+		if pending[i] {
+			errs[i] = multierr.Named(c.name, multierr.Invoke(ctx.Err)).Invoke()
+		}
+	}
+	return multierr.Combine(errs...)
+}
+
+// stop calls the Invoker of the component, labeling its failure with the
+// name of the component.
+func (c component) stop() error {
+	return multierr.Named(c.name, multierr.Invoke(c.invoke)).Invoke()
+}
+
+// invoke calls the Invoker of the component, reporting a panic inside it as
+// its failure.
+func (c component) invoke() (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicError(p)
+		}
+	}()
+
+	return c.invoker.Invoke()
+}
+
+// panicError builds the failure of a component that panicked with the value
+// p. If p is an error, it remains reachable with errors.Is and errors.As.
+func panicError(p interface{}) error {
+	if err, ok := p.(error); ok {
+		return fmt.Errorf("panic: %w", err)
+	}
+	return fmt.Errorf("panic: %v", p)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+// shutdownLog records the order in which components are stopped.
+type shutdownLog struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (l *shutdownLog) invoker(name string, err error) multierr.Invoker {
+	return multierr.Invoke(func() error {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.events = append(l.events, name)
+		return err
+	})
+}
+
+func (l *shutdownLog) Events() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.events...)
+}
+
+func TestPhases(t *testing.T) {
+	var log shutdownLog
+	s := New()
+	s.Register(2, "database", log.invoker("database", nil))
+	s.Register(0, "server", log.invoker("server", nil))
+	s.Register(1, "cache", log.invoker("cache", nil))
+	s.Register(-1, "health check", log.invoker("health check", nil))
+
+	require.NoError(t, s.Run(context.Background()))
+	assert.Equal(t, []string{"health check", "server", "cache", "database"}, log.Events())
+}
+
+func TestParallelWithinPhase(t *testing.T) {
+	// Each component of phase 0 waits for the other to start, so they
+	// only finish if they run concurrently.
+	var started sync.WaitGroup
+	started.Add(2)
+	waitForOther := multierr.Invoke(func() error {
+		started.Done()
+		started.Wait()
+		return nil
+	})
+
+	var log shutdownLog
+	s := New(Timeout(time.Second))
+	s.Register(0, "a", waitForOther)
+	s.Register(0, "b", waitForOther)
+	s.Register(1, "c", log.invoker("c", nil))
+
+	require.NoError(t, s.Run(context.Background()))
+	assert.Equal(t, []string{"c"}, log.Events())
+}
+
+func TestErrors(t *testing.T) {
+	var log shutdownLog
+	errServer := errors.New("address in use")
+	errDB := errors.New("connection reset")
+
+	s := New()
+	s.Register(0, "server", log.invoker("server", errServer))
+	s.Register(0, "consumer", log.invoker("consumer", nil))
+	s.Register(1, "database", log.invoker("database", errDB))
+
+	err := s.Run(context.Background())
+	require.Error(t, err)
+	assert.Len(t, log.Events(), 3, "all components must be stopped")
+
+	assert.Equal(t, "server: address in use; database: connection reset", err.Error())
+	assert.ErrorIs(t, err, errServer)
+	assert.ErrorIs(t, err, errDB)
+
+	var ops []string
+	for _, e := range multierr.Errors(err) {
+		ops = append(ops, multierr.Op(e))
+	}
+	assert.Equal(t, []string{"server", "database"}, ops)
+}
+
+func TestRunTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	s := New(Timeout(10 * time.Millisecond))
+	s.Register(0, "slow", multierr.Invoke(func() error {
+		<-release
+		return nil
+	}))
+
+	err := s.Run(context.Background())
+	assert.EqualError(t, err, "slow: context deadline exceeded")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestStopsWaiting(t *testing.T) {
+	var log shutdownLog
+	release := make(chan struct{})
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := New()
+	s.Register(0, "fast", log.invoker("fast", nil))
+	s.Register(1, "slow", multierr.Invoke(func() error {
+		// The earlier phase has finished by now, so the context is
+		// done only while this component is running.
+		cancel()
+		<-release
+		return nil
+	}))
+	s.Register(2, "database", log.invoker("database", nil))
+
+	err := s.Run(ctx)
+	assert.Equal(t,
+		"slow: context canceled; database: context canceled",
+		err.Error())
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"fast"}, log.Events(),
+		"later phases must not run after the context is done")
+}
+
+func TestPanic(t *testing.T) {
+	var log shutdownLog
+	s := New()
+	s.Register(0, "server", multierr.Invoke(func() error {
+		panic("great sadness")
+	}))
+	s.Register(0, "consumer", log.invoker("consumer", nil))
+	s.Register(1, "database", log.invoker("database", nil))
+
+	err := s.Run(context.Background())
+	assert.EqualError(t, err, "server: panic: great sadness")
+	assert.Equal(t, "server", multierr.Op(err))
+	assert.ElementsMatch(t, []string{"consumer", "database"}, log.Events())
+}
+
+func TestPanicWithError(t *testing.T) {
+	errPanic := errors.New("great sadness")
+
+	s := New()
+	s.Register(0, "server", multierr.Invoke(func() error {
+		panic(errPanic)
+	}))
+
+	err := s.Run(context.Background())
+	assert.EqualError(t, err, "server: panic: great sadness")
+	assert.ErrorIs(t, err, errPanic)
+}
+
+func TestRunContextDone(t *testing.T) {
+	var log shutdownLog
+	s := New()
+	s.Register(0, "server", log.invoker("server", nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := s.Run(ctx)
+	assert.EqualError(t, err, "server: context canceled")
+	assert.Equal(t, "server", multierr.Op(err))
+	assert.Empty(t, log.Events())
+}
+
+func TestRunOnce(t *testing.T) {
+	var log shutdownLog
+	s := New()
+	s.Register(0, "server", log.invoker("server", errors.New("great sadness")))
+
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = s.Run(context.Background())
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, []string{"server"}, log.Events())
+	for _, err := range errs {
+		assert.Same(t, errs[0], err)
+	}
+}
+
+func TestRunOnSignal(t *testing.T) {
+	var log shutdownLog
+	s := New()
+	s.Register(0, "server", log.invoker("server", nil))
+
+	sig := make(chan os.Signal, 1)
+	done := make(chan error)
+	go func() {
+		done <- s.RunOnSignal(sig)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("RunOnSignal must wait for a signal")
+	case <-time.After(10 * time.Millisecond):
+	}
+	assert.Empty(t, log.Events())
+
+	sig <- syscall.SIGTERM
+	require.NoError(t, <-done)
+	assert.Equal(t, []string{"server"}, log.Events())
+}
+
+func TestRunEmpty(t *testing.T) {
+	assert.NoError(t, New().Run(context.Background()))
+}
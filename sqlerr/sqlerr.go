@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package sqlerr runs database/sql transactions, combining the failures of
+// the transaction with the failures of committing or rolling it back.
+//
+//	err := sqlerr.WithTx(ctx, db, nil, func(tx *sql.Tx) error {
+//		if _, err := tx.ExecContext(ctx, "UPDATE ..."); err != nil {
+//			return err
+//		}
+//		_, err := tx.ExecContext(ctx, "INSERT ...")
+//		return err
+//	})
+package sqlerr // import "go.uber.org/multierr/sqlerr"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.uber.org/multierr"
+)
+
+// TxBeginner starts transactions. *sql.DB and *sql.Conn are TxBeginners.
+type TxBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+var (
+	_ TxBeginner = (*sql.DB)(nil)
+	_ TxBeginner = (*sql.Conn)(nil)
+)
+
+// WithTx runs fn inside a transaction started on db with the given options.
+// opts may be nil.
+//
+// If fn succeeds, the transaction is committed and the failure to commit it,
+// if any, is returned. If fn fails or panics, the transaction is rolled back.
+// The failure to roll it back is combined with the failure of fn using
+// [multierr.Append], so neither is lost. Commit and rollback failures are
+// labeled "commit" and "rollback"; use [multierr.Op] to tell them apart.
+//
+// fn may commit or roll back the transaction itself. WithTx then leaves it
+// be, and does not report the resulting sql.ErrTxDone. If the transaction
+// was instead rolled back because ctx was done before fn returned, WithTx
+// reports the context's error, unlabeled.
+//
+// database/sql reports both cases with sql.ErrTxDone, so WithTx can't tell
+// them apart when fn ended the transaction and ctx was also done before fn
+// returned. It reports the context's error then, even if fn committed the
+// transaction successfully. Functions that commit the transaction
+// themselves should check their own result instead of relying on WithTx.
+func WithTx(ctx context.Context, db TxBeginner, opts *sql.TxOptions, fn func(*sql.Tx) error) (err error) {
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		return multierr.Append(err, rollback(tx))
+	}
+
+	// Check the context before committing: if it's done now, the
+	// transaction may have been rolled back on its account.
+	ctxErr := ctx.Err()
+	err = multierr.Named("commit", multierr.Invoke(tx.Commit)).Invoke()
+	if errors.Is(err, sql.ErrTxDone) {
+		// Either fn ended the transaction itself, or it was rolled back
+		// because the context was done.
+		return ctxErr
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+		// Commit refuses to run once the context is done.
+		return ctxErr
+	}
+	return err
+}
+
+func rollback(tx *sql.Tx) error {
+	err := multierr.Named("rollback", multierr.Invoke(tx.Rollback)).Invoke()
+	if errors.Is(err, sql.ErrTxDone) {
+		// fn already committed or rolled back, or the context is done
+		// and the transaction was rolled back for us.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlerr
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+// fakeDB is an in-process database/sql driver that records the operations
+// it receives and fails them on demand.
+type fakeDB struct {
+	BeginErr    error
+	CommitErr   error
+	RollbackErr error
+
+	mu     sync.Mutex
+	events []string
+	opts   driver.TxOptions
+}
+
+var (
+	_ driver.Connector          = (*fakeDB)(nil)
+	_ driver.ConnBeginTx        = (*fakeConn)(nil)
+	_ driver.StmtExecContext    = (*fakeStmt)(nil)
+	_ driver.Tx                 = (*fakeTx)(nil)
+	_ driver.SessionResetter    = (*fakeConn)(nil)
+	_ driver.NamedValueChecker  = (*fakeStmt)(nil)
+	_ driver.ExecerContext      = (*fakeConn)(nil)
+	_ driver.ConnPrepareContext = (*fakeConn)(nil)
+)
+
+func newFakeDB(t *testing.T) (*fakeDB, *sql.DB) {
+	f := &fakeDB{}
+	db := sql.OpenDB(f)
+	t.Cleanup(func() { assert.NoError(t, db.Close()) })
+	return f, db
+}
+
+func (f *fakeDB) record(event string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event)
+}
+
+// Events returns the operations received by the database so far.
+func (f *fakeDB) Events() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.events...)
+}
+
+func (f *fakeDB) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: f}, nil }
+func (f *fakeDB) Driver() driver.Driver                        { return fakeDriver{} }
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("use sql.OpenDB")
+}
+
+type fakeConn struct{ db *fakeDB }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *fakeConn) PrepareContext(_ context.Context, query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.db.record(query)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) Close() error                       { return nil }
+func (c *fakeConn) ResetSession(context.Context) error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(_ context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if err := c.db.BeginErr; err != nil {
+		return nil, err
+	}
+	c.db.mu.Lock()
+	c.db.opts = opts
+	c.db.mu.Unlock()
+	c.db.record("BEGIN")
+	return &fakeTx{db: c.db}, nil
+}
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error                             { return nil }
+func (s *fakeStmt) NumInput() int                            { return -1 }
+func (s *fakeStmt) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), nil)
+}
+
+func (s *fakeStmt) ExecContext(context.Context, []driver.NamedValue) (driver.Result, error) {
+	s.db.record(s.query)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return nil, errors.New("queries are not supported")
+}
+
+type fakeTx struct{ db *fakeDB }
+
+func (tx *fakeTx) Commit() error {
+	tx.db.record("COMMIT")
+	return tx.db.CommitErr
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.db.record("ROLLBACK")
+	return tx.db.RollbackErr
+}
+
+func exec(query string) func(*sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		_, err := tx.Exec(query)
+		return err
+	}
+}
+
+func TestWithTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("commit", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		require.NoError(t, WithTx(ctx, db, nil, exec("INSERT")))
+		assert.Equal(t, []string{"BEGIN", "INSERT", "COMMIT"}, f.Events())
+	})
+
+	t.Run("options", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		opts := &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: true}
+		require.NoError(t, WithTx(ctx, db, opts, exec("SELECT")))
+		assert.Equal(t, driver.TxOptions{
+			Isolation: driver.IsolationLevel(sql.LevelSerializable),
+			ReadOnly:  true,
+		}, f.opts)
+	})
+
+	t.Run("conn", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		conn, err := db.Conn(ctx)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		require.NoError(t, WithTx(ctx, conn, nil, exec("INSERT")))
+		assert.Equal(t, []string{"BEGIN", "INSERT", "COMMIT"}, f.Events())
+	})
+
+	t.Run("begin failure", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		f.BeginErr = errors.New("too many connections")
+
+		err := WithTx(ctx, db, nil, func(*sql.Tx) error {
+			t.Fatal("fn must not be called")
+			return nil
+		})
+		assert.ErrorIs(t, err, f.BeginErr)
+		assert.Empty(t, f.Events())
+	})
+
+	t.Run("commit failure", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		f.CommitErr = errors.New("serialization failure")
+
+		err := WithTx(ctx, db, nil, exec("INSERT"))
+		assert.EqualError(t, err, "commit: serialization failure")
+		assert.ErrorIs(t, err, f.CommitErr)
+		assert.Equal(t, "commit", multierr.Op(err))
+		assert.Equal(t, []string{"BEGIN", "INSERT", "COMMIT"}, f.Events())
+	})
+
+	t.Run("fn failure", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		errFn := errors.New("great sadness")
+
+		err := WithTx(ctx, db, nil, func(tx *sql.Tx) error {
+			if _, err := tx.Exec("INSERT"); err != nil {
+				return err
+			}
+			return errFn
+		})
+		assert.Same(t, errFn, err, "fn error must be returned as is")
+		assert.Equal(t, []string{"BEGIN", "INSERT", "ROLLBACK"}, f.Events())
+	})
+
+	t.Run("fn and rollback failure", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		f.RollbackErr = errors.New("connection reset")
+		errFn := errors.New("great sadness")
+
+		err := WithTx(ctx, db, nil, func(*sql.Tx) error { return errFn })
+		assert.EqualError(t, err, "great sadness; rollback: connection reset")
+
+		errs := multierr.Errors(err)
+		require.Len(t, errs, 2)
+		assert.Same(t, errFn, errs[0])
+		assert.ErrorIs(t, errs[1], f.RollbackErr)
+		assert.Equal(t, "rollback", multierr.Op(errs[1]))
+	})
+
+	t.Run("fn commits", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		err := WithTx(ctx, db, nil, func(tx *sql.Tx) error {
+			return tx.Commit()
+		})
+		require.NoError(t, err, "ErrTxDone must not be reported")
+		assert.Equal(t, []string{"BEGIN", "COMMIT"}, f.Events())
+	})
+
+	t.Run("fn rolls back and fails", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		errFn := errors.New("great sadness")
+
+		err := WithTx(ctx, db, nil, func(tx *sql.Tx) error {
+			return multierr.Append(errFn, tx.Rollback())
+		})
+		assert.Same(t, errFn, err, "ErrTxDone must not be reported")
+		assert.Equal(t, []string{"BEGIN", "ROLLBACK"}, f.Events())
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		_, db := newFakeDB(t)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		err := WithTx(ctx, db, nil, func(tx *sql.Tx) error {
+			cancel()
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err, "transaction was not committed")
+		assert.Empty(t, multierr.Op(err), "context errors must not be labeled")
+	})
+
+	t.Run("fn commits and context canceled", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		err := WithTx(ctx, db, nil, func(tx *sql.Tx) error {
+			err := tx.Commit()
+			cancel()
+			return err
+		})
+		// WithTx can't tell that fn committed the transaction.
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, []string{"BEGIN", "COMMIT"}, f.Events())
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		f, db := newFakeDB(t)
+		assert.PanicsWithValue(t, "great sadness", func() {
+			_ = WithTx(ctx, db, nil, func(tx *sql.Tx) error {
+				panic("great sadness")
+			})
+		})
+		assert.Equal(t, []string{"BEGIN", "ROLLBACK"}, f.Events())
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// _summarySamples is the maximum number of sample errors kept for each group
+// of a Summary.
+const _summarySamples = 3
+
+// _summaryOtherKey is the key of the group holding errors that a Classifier
+// didn't assign to any group.
+const _summaryOtherKey = "other"
+
+// Classifier assigns errors to groups for [Summarize]. It returns the key of
+// the group that the given error belongs to, or an empty string if the error
+// should be placed in the catch-all "other" group.
+type Classifier func(error) string
+
+// ClassifyByType builds a Classifier that groups errors by their dynamic
+// type, for example "*net.OpError".
+//
+// Errors that match one of the given sentinels with errors.Is are grouped
+// under the message of that sentinel instead, for example
+// "context deadline exceeded". Sentinels are checked in order.
+func ClassifyByType(sentinels ...error) Classifier {
+	return func(err error) string {
+		for _, sentinel := range sentinels {
+			if errors.Is(err, sentinel) {
+				return sentinel.Error()
+			}
+		}
+		return fmt.Sprintf("%T", err)
+	}
+}
+
+// Summary is an overview of the errors that a combined error is made of,
+// grouped by a Classifier. Use [Summarize] to build one.
+//
+// Summary formats into a one-line description of the groups with %v.
+//
+//	12 errors: 9x *net.OpError, 2x context deadline exceeded, 1x other
+type Summary struct {
+	// Total number of errors.
+	Total int
+
+	// Groups of errors, ordered from the largest to the smallest. Groups
+	// of the same size are ordered by their first occurrence.
+	Groups []SummaryGroup
+}
+
+// SummaryGroup is a group of errors that a Classifier assigned the same key.
+type SummaryGroup struct {
+	// Key returned by the Classifier for errors in this group.
+	Key string
+
+	// Number of errors in this group.
+	Count int
+
+	// Up to the first three errors in this group.
+	Samples []error
+}
+
+// Summarize groups the errors that the given error is composed of with the
+// provided Classifier, and counts them.
+//
+//	summary := multierr.Summarize(err, multierr.ClassifyByType(context.DeadlineExceeded))
+//	log.Print(summary)
+//	// 12 errors: 9x *net.OpError, 2x context deadline exceeded, 1x other
+//
+// If classify is nil, errors are grouped with ClassifyByType().
+func Summarize(err error, classify Classifier) Summary {
+	if classify == nil {
+		classify = ClassifyByType()
+	}
+
+	var (
+		s       Summary
+		indexes = make(map[string]int) // key => index in s.Groups
+	)
+	for _, item := range viewErrors(err) {
+		key := classify(item)
+		if key == "" {
+			key = _summaryOtherKey
+		}
+
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(s.Groups)
+			indexes[key] = idx
+			s.Groups = append(s.Groups, SummaryGroup{Key: key})
+		}
+
+		g := &s.Groups[idx]
+		g.Count++
+		if len(g.Samples) < _summarySamples {
+			g.Samples = append(g.Samples, item)
+		}
+		s.Total++
+	}
+
+	sort.SliceStable(s.Groups, func(i, j int) bool {
+		return s.Groups[i].Count > s.Groups[j].Count
+	})
+	return s
+}
+
+// String returns a one-line description of the summary.
+func (s Summary) String() string {
+	var sb strings.Builder
+	sb.WriteString(strconv.Itoa(s.Total))
+	if s.Total == 1 {
+		sb.WriteString(" error")
+	} else {
+		sb.WriteString(" errors")
+	}
+
+	for i, g := range s.Groups {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(strconv.Itoa(g.Count))
+		sb.WriteString("x ")
+		sb.WriteString(g.Key)
+	}
+	return sb.String()
+}
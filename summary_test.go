@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarize(t *testing.T) {
+	pathErr := func(path string) error {
+		return &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+
+	tests := []struct {
+		desc     string
+		give     error
+		classify Classifier
+		want     Summary
+		wantStr  string
+	}{
+		{
+			desc:    "nil",
+			want:    Summary{},
+			wantStr: "0 errors",
+		},
+		{
+			desc: "single error",
+			give: io.EOF,
+			want: Summary{
+				Total: 1,
+				Groups: []SummaryGroup{
+					{Key: "*errors.errorString", Count: 1, Samples: []error{io.EOF}},
+				},
+			},
+			wantStr: "1 error: 1x *errors.errorString",
+		},
+		{
+			desc: "by type",
+			give: Combine(
+				pathErr("a"),
+				io.EOF,
+				pathErr("b"),
+				pathErr("c"),
+				pathErr("d"),
+			),
+			want: Summary{
+				Total: 5,
+				Groups: []SummaryGroup{
+					{
+						Key:     "*fs.PathError",
+						Count:   4,
+						Samples: []error{pathErr("a"), pathErr("b"), pathErr("c")},
+					},
+					{Key: "*errors.errorString", Count: 1, Samples: []error{io.EOF}},
+				},
+			},
+			wantStr: "5 errors: 4x *fs.PathError, 1x *errors.errorString",
+		},
+		{
+			desc: "sentinels",
+			give: Combine(
+				io.EOF,
+				fmt.Errorf("wait: %w", context.DeadlineExceeded),
+				pathErr("a"),
+				context.DeadlineExceeded,
+			),
+			classify: ClassifyByType(context.DeadlineExceeded, os.ErrNotExist),
+			want: Summary{
+				Total: 4,
+				Groups: []SummaryGroup{
+					{
+						Key:   "context deadline exceeded",
+						Count: 2,
+						Samples: []error{
+							fmt.Errorf("wait: %w", context.DeadlineExceeded),
+							context.DeadlineExceeded,
+						},
+					},
+					{Key: "*errors.errorString", Count: 1, Samples: []error{io.EOF}},
+					{Key: "file does not exist", Count: 1, Samples: []error{pathErr("a")}},
+				},
+			},
+			wantStr: "4 errors: 2x context deadline exceeded, 1x *errors.errorString, 1x file does not exist",
+		},
+		{
+			desc: "other",
+			give: Combine(io.EOF, io.ErrUnexpectedEOF, os.ErrClosed),
+			classify: func(err error) string {
+				if errors.Is(err, io.EOF) {
+					return "eof"
+				}
+				return ""
+			},
+			want: Summary{
+				Total: 3,
+				Groups: []SummaryGroup{
+					{
+						Key:     "other",
+						Count:   2,
+						Samples: []error{io.ErrUnexpectedEOF, os.ErrClosed},
+					},
+					{Key: "eof", Count: 1, Samples: []error{io.EOF}},
+				},
+			},
+			wantStr: "3 errors: 2x other, 1x eof",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := Summarize(tt.give, tt.classify)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantStr, got.String())
+			assert.Equal(t, tt.wantStr, fmt.Sprintf("%v", got))
+		})
+	}
+}
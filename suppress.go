@@ -0,0 +1,156 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"fmt"
+	"io"
+)
+
+// _suppressedPrefix introduces the list of suppressed errors in the
+// multi-line message of an error built with Suppress.
+var _suppressedPrefix = []byte("\nthe following errors were suppressed:")
+
+// Suppress attaches secondary failures to a primary error without making
+// them its peers. Use it to record failures that occurred while handling
+// the primary error, such as failures to clean up after it.
+//
+//	err = multierr.Suppress(err, conn.Close())
+//
+// The returned error reports the message of the primary error with %v and
+// Error(), and additionally lists the suppressed errors with %+v. errors.Is
+// and errors.As match both the primary and the suppressed errors. Use
+// [Primary] and [Suppressed] to tell them apart.
+//
+// Suppressed errors are not counted among the errors that the returned error
+// is composed of. [Errors], [Len] and the functions built on them see only
+// the primary error, or the errors that it is composed of.
+//
+// nil secondary errors are ignored. If all of them are nil, primary is
+// returned as-is. If primary is nil, there is nothing to attach the
+// secondary errors to, and they are combined with [Combine] instead.
+//
+// Suppressing errors into an error returned by Suppress adds to its list of
+// suppressed errors.
+func Suppress(primary error, secondary ...error) error {
+	if primary == nil {
+		return Combine(secondary...)
+	}
+
+	serr, _ := primary.(*suppressedError)
+	var errs []error
+	for _, err := range secondary {
+		if err == nil {
+			continue
+		}
+		if errs == nil {
+			// Copy the errors of the original error instead of
+			// appending to them so that it isn't modified.
+			if serr != nil {
+				errs = append(errs, serr.errors...)
+			} else {
+				errs = append(errs, primary)
+			}
+		}
+		errs = append(errs, err)
+	}
+
+	if errs == nil {
+		return primary
+	}
+	return &suppressedError{errors: errs}
+}
+
+// Primary returns the primary error of an error built with [Suppress].
+// For all other errors, it returns the error as-is.
+func Primary(err error) error {
+	if serr, ok := err.(*suppressedError); ok {
+		return serr.errors[0]
+	}
+	return err
+}
+
+// Suppressed returns the errors suppressed by an error built with
+// [Suppress]. It returns nil for all other errors.
+//
+// Callers of this function are free to modify the returned slice.
+func Suppressed(err error) []error {
+	if serr, ok := err.(*suppressedError); ok {
+		return append(([]error)(nil), serr.errors[1:]...)
+	}
+	return nil
+}
+
+// AppendInvokeSuppressed is a variant of [AppendInvoke] that attaches the
+// result of calling the given Invoker to the error pointed to by into as a
+// suppressed error. See [Suppress] for more information.
+//
+//	func sendRequest(req Request) (err error) {
+//		conn, err := openConnection()
+//		if err != nil {
+//			return err
+//		}
+//		defer multierr.AppendInvokeSuppressed(&err, multierr.Close(conn))
+//		// ...
+//	}
+//
+// If the function returned without an error, the result of the Invoker
+// becomes the returned error.
+//
+// NOTE: If used with a defer, the error variable MUST be a named return.
+func AppendInvokeSuppressed(into *error, invoker Invoker) {
+	if into == nil {
+		panic("misuse of multierr.AppendInvokeSuppressed: into pointer must not be nil")
+	}
+
+	if err := invoker.Invoke(); err != nil {
+		*into = Suppress(*into, err)
+	}
+}
+
+// suppressedError is a primary error with secondary errors attached to it.
+type suppressedError struct {
+	// The primary error followed by one or more suppressed errors.
+	errors []error
+}
+
+func (e *suppressedError) Error() string {
+	return e.errors[0].Error()
+}
+
+// Unwrap returns the primary error followed by the suppressed errors.
+func (e *suppressedError) Unwrap() []error {
+	return e.errors
+}
+
+func (e *suppressedError) Format(f fmt.State, c rune) {
+	if c != 'v' || !f.Flag('+') {
+		io.WriteString(f, e.Error())
+		return
+	}
+
+	io.WriteString(f, fmt.Sprintf("%+v", e.errors[0]))
+	f.Write(_suppressedPrefix)
+	for _, item := range e.errors[1:] {
+		f.Write(_multilineSeparator)
+		writePrefixLine(f, _multilineIndent, fmt.Sprintf("%+v", item))
+	}
+}
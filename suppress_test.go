@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuppress(t *testing.T) {
+	var (
+		errPrimary = errors.New("request failed")
+		errClose   = errors.New("close failed")
+		errFlush   = errors.New("flush failed")
+	)
+
+	t.Run("no secondary errors", func(t *testing.T) {
+		assert.Same(t, errPrimary, Suppress(errPrimary))
+		assert.Same(t, errPrimary, Suppress(errPrimary, nil, nil))
+	})
+
+	t.Run("no primary error", func(t *testing.T) {
+		assert.Nil(t, Suppress(nil))
+		assert.Same(t, errClose, Suppress(nil, nil, errClose))
+		assert.Equal(t, Combine(errClose, errFlush), Suppress(nil, errClose, errFlush))
+	})
+
+	t.Run("suppressed", func(t *testing.T) {
+		err := Suppress(errPrimary, errClose, nil, errFlush)
+		require.Error(t, err)
+
+		assert.Equal(t, "request failed", err.Error())
+		assert.Equal(t, "request failed", fmt.Sprintf("%v", err))
+		assert.Equal(t, "request failed\n"+
+			"the following errors were suppressed:\n"+
+			" -  close failed\n"+
+			" -  flush failed", fmt.Sprintf("%+v", err))
+
+		assert.ErrorIs(t, err, errPrimary)
+		assert.ErrorIs(t, err, errClose)
+		assert.ErrorIs(t, err, errFlush)
+
+		assert.Same(t, errPrimary, Primary(err))
+		assert.Equal(t, []error{errClose, errFlush}, Suppressed(err))
+	})
+
+	t.Run("suppress more", func(t *testing.T) {
+		first := Suppress(errPrimary, errClose)
+		second := Suppress(first, errFlush)
+
+		assert.Same(t, errPrimary, Primary(second))
+		assert.Equal(t, []error{errClose, errFlush}, Suppressed(second))
+		assert.Equal(t, []error{errClose}, Suppressed(first), "original must not be modified")
+		assert.Same(t, first, Suppress(first, nil))
+	})
+
+	t.Run("As", func(t *testing.T) {
+		err := Suppress(errPrimary, richFormatError{})
+		var rich richFormatError
+		assert.ErrorAs(t, err, &rich)
+		assert.Equal(t, "request failed\n"+
+			"the following errors were suppressed:\n"+
+			" -  multiline\n"+
+			"    message\n"+
+			"    with plus", fmt.Sprintf("%+v", err))
+	})
+}
+
+func TestSuppressedAreNotPeers(t *testing.T) {
+	errPrimary := errors.New("request failed")
+	errClose := errors.New("close failed")
+
+	t.Run("single primary", func(t *testing.T) {
+		err := Suppress(errPrimary, errClose)
+		assert.Equal(t, []error{err}, Errors(err))
+		assert.Equal(t, 1, Len(err))
+		assert.Same(t, err, At(err, 0))
+		assert.Equal(t, 1, Summarize(err, nil).Total)
+		assert.Equal(t, "the following errors occurred:\n"+
+			" [1/2] foo\n"+
+			" [2/2] request failed\n"+
+			"     the following errors were suppressed:\n"+
+			"     -  close failed",
+			fmt.Sprintf("%#v", Combine(errors.New("foo"), err)))
+	})
+
+	t.Run("combined primary", func(t *testing.T) {
+		errFoo := errors.New("foo")
+		err := Suppress(Combine(errPrimary, errFoo), errClose)
+		assert.Equal(t, []error{errPrimary, errFoo}, Errors(err))
+		assert.Equal(t, 2, Len(err))
+		assert.Equal(t, 2, Summarize(err, nil).Total)
+		assert.ErrorIs(t, err, errClose, "suppressed errors must still match")
+	})
+}
+
+func TestPrimarySuppressedOtherErrors(t *testing.T) {
+	err := errors.New("great sadness")
+	assert.Same(t, err, Primary(err))
+	assert.Nil(t, Suppressed(err))
+	assert.Nil(t, Primary(nil))
+	assert.Nil(t, Suppressed(nil))
+
+	combined := Combine(err, errors.New("foo"))
+	assert.Equal(t, combined, Primary(combined))
+	assert.Nil(t, Suppressed(combined))
+}
+
+func TestAppendInvokeSuppressed(t *testing.T) {
+	var (
+		errPrimary = errors.New("request failed")
+		errClose   = errors.New("close failed")
+	)
+
+	t.Run("primary failed", func(t *testing.T) {
+		err := func() (err error) {
+			defer AppendInvokeSuppressed(&err, Close(newCloserMock(t, errClose)))
+			return errPrimary
+		}()
+		assert.Same(t, errPrimary, Primary(err))
+		assert.Equal(t, []error{errClose}, Suppressed(err))
+	})
+
+	t.Run("primary succeeded", func(t *testing.T) {
+		err := func() (err error) {
+			defer AppendInvokeSuppressed(&err, Close(newCloserMock(t, errClose)))
+			return nil
+		}()
+		assert.Same(t, errClose, err)
+	})
+
+	t.Run("cleanup succeeded", func(t *testing.T) {
+		err := func() (err error) {
+			defer AppendInvokeSuppressed(&err, Close(newCloserMock(t, nil)))
+			return errPrimary
+		}()
+		assert.Same(t, errPrimary, err)
+	})
+
+	t.Run("nil pointer panics", func(t *testing.T) {
+		assert.Panics(t, func() {
+			AppendInvokeSuppressed(nil, Invoke(func() error { return nil }))
+		})
+	})
+}
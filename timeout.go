@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// AnyError makes [IsTimeout], [IsTemporary] and [WithProperties] report
+// true if any of the errors inside a combined error has the property,
+// instead of requiring all of them to have it.
+func AnyError() Option {
+	return optionFunc(func(o *options) {
+		o.anyError = true
+	})
+}
+
+// IsTimeout reports whether err is a timeout, as indicated by a
+// Timeout() bool method on it or on an error it wraps.
+//
+//	if multierr.IsTimeout(err) {
+//		// retry
+//	}
+//
+// For errors composed of other errors, IsTimeout evaluates the whole tree of
+// errors, and reports true only if all errors inside it are timeouts. Pass
+// [AnyError] to report true if any of them is. Errors built with [Suppress]
+// are timeouts if their primary error is.
+func IsTimeout(err error, opts ...Option) bool {
+	return hasProperty(err, timeoutProperty, newOptions(opts).anyError)
+}
+
+// IsTemporary reports whether err is temporary, as indicated by a
+// Temporary() bool method on it or on an error it wraps.
+//
+// IsTemporary evaluates errors composed of other errors the same way as
+// [IsTimeout].
+func IsTemporary(err error, opts ...Option) bool {
+	return hasProperty(err, temporaryProperty, newOptions(opts).anyError)
+}
+
+// WithProperties returns an error that implements the Timeout() and
+// Temporary() methods of net.Error, reporting the results of [IsTimeout]
+// and [IsTemporary] for the given error and options. It returns nil if err
+// is nil.
+//
+//	return multierr.WithProperties(multierr.Combine(errs...), multierr.AnyError())
+//
+// Errors combined by this package don't implement these methods on their
+// own, so that errors.As finds the errors inside them that do. Use
+// WithProperties to hand a combined error to code that checks for these
+// methods directly. errors.As stops at the returned error when looking for
+// a net.Error.
+//
+// The returned error renders the same message as err, and is transparent
+// to [Errors] and errors.Is.
+func WithProperties(err error, opts ...Option) error {
+	if err == nil {
+		return nil
+	}
+	return &propertyError{err: err, anyError: newOptions(opts).anyError}
+}
+
+// propertyError is an error that declares the Timeout and Temporary
+// properties of the error it wraps.
+type propertyError struct {
+	err      error
+	anyError bool
+}
+
+func (e *propertyError) Error() string {
+	return e.err.Error()
+}
+
+func (e *propertyError) Format(f fmt.State, c rune) {
+	switch {
+	case c == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%#v", e.err)
+	case c == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "%+v", e.err)
+	default:
+		io.WriteString(f, e.err.Error())
+	}
+}
+
+// Unwrap returns the list of errors that the wrapped error is composed of.
+func (e *propertyError) Unwrap() []error {
+	if errs, ok := unwrapErrors(e.err); ok {
+		return errs
+	}
+	return []error{e.err}
+}
+
+// Timeout reports whether the wrapped error is a timeout.
+func (e *propertyError) Timeout() bool {
+	return hasProperty(e.err, timeoutProperty, e.anyError)
+}
+
+// Temporary reports whether the wrapped error is temporary.
+func (e *propertyError) Temporary() bool {
+	return hasProperty(e.err, temporaryProperty, e.anyError)
+}
+
+// property reports the value of a property of an error, and whether the
+// error declares it.
+type property func(error) (value, ok bool)
+
+func timeoutProperty(err error) (value, ok bool) {
+	if t, ok := err.(interface{ Timeout() bool }); ok {
+		return t.Timeout(), true
+	}
+	return false, false
+}
+
+func temporaryProperty(err error) (value, ok bool) {
+	if t, ok := err.(interface{ Temporary() bool }); ok {
+		return t.Temporary(), true
+	}
+	return false, false
+}
+
+// hasProperty evaluates a property for the tree of errors rooted at err.
+// If anyError is true, it reports whether any error in the tree has the
+// property. Otherwise, it reports whether all of them do.
+func hasProperty(err error, prop property, anyError bool) bool {
+	if err == nil {
+		return false
+	}
+	if value, ok := prop(err); ok {
+		return value
+	}
+
+	if serr, ok := err.(*suppressedError); ok {
+		return hasProperty(serr.errors[0], prop, anyError)
+	}
+
+	errs, ok := unwrapErrors(err)
+	if !ok {
+		return hasProperty(errors.Unwrap(err), prop, anyError)
+	}
+
+	if len(errs) == 0 {
+		return false
+	}
+	for _, e := range errs {
+		if hasProperty(e, prop, anyError) == anyError {
+			// Short-circuit on the first match for "any", and
+			// the first mismatch for "all".
+			return anyError
+		}
+	}
+	return !anyError
+}
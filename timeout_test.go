@@ -0,0 +1,234 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// netError mimics the net.Error interface.
+type netError struct{ timeout, temporary bool }
+
+func (e netError) Error() string   { return fmt.Sprintf("net error %v %v", e.timeout, e.temporary) }
+func (e netError) Timeout() bool   { return e.timeout }
+func (e netError) Temporary() bool { return e.temporary }
+
+func TestIsTimeout(t *testing.T) {
+	var (
+		timeout = netError{timeout: true}
+		fatal   = netError{}
+		plain   = errors.New("great sadness")
+	)
+
+	tests := []struct {
+		desc    string
+		give    error
+		wantAll bool
+		wantAny bool
+	}{
+		{desc: "nil"},
+		{desc: "plain", give: plain},
+		{desc: "timeout", give: timeout, wantAll: true, wantAny: true},
+		{desc: "not timeout", give: fatal},
+		{
+			desc:    "wrapped timeout",
+			give:    fmt.Errorf("dial: %w", timeout),
+			wantAll: true,
+			wantAny: true,
+		},
+		{
+			desc:    "all timeouts",
+			give:    Combine(timeout, timeout, fmt.Errorf("read: %w", timeout)),
+			wantAll: true,
+			wantAny: true,
+		},
+		{
+			desc:    "some timeouts",
+			give:    Combine(timeout, fatal, timeout),
+			wantAll: false,
+			wantAny: true,
+		},
+		{
+			desc:    "timeout and plain",
+			give:    Combine(plain, timeout),
+			wantAll: false,
+			wantAny: true,
+		},
+		{
+			desc:    "nested",
+			give:    Combine(timeout, fmt.Errorf("retry: %w", Combine(timeout, timeout))),
+			wantAll: true,
+			wantAny: true,
+		},
+		{
+			desc:    "nested with fatal",
+			give:    Combine(timeout, errors.Join(timeout, fatal)),
+			wantAll: false,
+			wantAny: true,
+		},
+		{
+			desc:    "group",
+			give:    Combine(timeout, Group("cleanup", timeout, timeout)),
+			wantAll: true,
+			wantAny: true,
+		},
+		{
+			desc:    "suppressed",
+			give:    Suppress(timeout, fatal),
+			wantAll: true,
+			wantAny: true,
+		},
+		{
+			desc: "suppressed timeout",
+			give: Suppress(fatal, timeout),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.wantAll, IsTimeout(tt.give), "all")
+			assert.Equal(t, tt.wantAny, IsTimeout(tt.give, AnyError()), "any")
+		})
+	}
+}
+
+func TestIsTemporary(t *testing.T) {
+	var (
+		temporary = netError{temporary: true}
+		fatal     = netError{timeout: true}
+	)
+
+	all := Combine(temporary, fmt.Errorf("wrapped: %w", temporary))
+	assert.True(t, IsTemporary(all))
+	assert.True(t, IsTemporary(all, AnyError()))
+
+	some := Combine(temporary, fatal)
+	assert.False(t, IsTemporary(some))
+	assert.True(t, IsTemporary(some, AnyError()))
+}
+
+func TestCombinedErrorsDontDeclareProperties(t *testing.T) {
+	type properties interface {
+		Timeout() bool
+		Temporary() bool
+	}
+
+	timeout := netError{timeout: true, temporary: true}
+	other := errors.New("great sadness")
+
+	tests := []struct {
+		desc string
+		give error
+	}{
+		{desc: "combined", give: Combine(timeout, timeout)},
+		{desc: "group", give: Group("g", timeout, timeout)},
+		{desc: "suppressed", give: Suppress(timeout, other)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, ok := tt.give.(properties)
+			assert.False(t, ok, "must not implement Timeout and Temporary")
+
+			// errors.As must find the error inside that declares them.
+			var p properties
+			if assert.True(t, errors.As(tt.give, &p)) {
+				assert.Equal(t, timeout, p)
+			}
+		})
+	}
+
+	t.Run("first match", func(t *testing.T) {
+		var p properties
+		if assert.True(t, errors.As(Combine(other, netError{}, timeout), &p)) {
+			assert.Equal(t, netError{}, p)
+		}
+	})
+}
+
+func TestWithProperties(t *testing.T) {
+	type properties interface {
+		Timeout() bool
+		Temporary() bool
+	}
+
+	var (
+		timeout   = netError{timeout: true}
+		temporary = netError{temporary: true}
+		plain     = errors.New("great sadness")
+	)
+
+	assert.Nil(t, WithProperties(nil))
+
+	tests := []struct {
+		desc          string
+		give          error
+		opts          []Option
+		wantTimeout   bool
+		wantTemporary bool
+	}{
+		{desc: "plain", give: plain},
+		{desc: "single", give: timeout, wantTimeout: true},
+		{
+			desc:        "all timeouts",
+			give:        Combine(timeout, fmt.Errorf("read: %w", timeout)),
+			wantTimeout: true,
+		},
+		{desc: "some timeouts", give: Combine(timeout, temporary)},
+		{
+			desc:          "some timeouts, any",
+			give:          Combine(timeout, temporary),
+			opts:          []Option{AnyError()},
+			wantTimeout:   true,
+			wantTemporary: true,
+		},
+		{
+			desc:        "suppressed",
+			give:        Suppress(timeout, temporary),
+			wantTimeout: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := WithProperties(tt.give, tt.opts...)
+
+			p, ok := err.(properties)
+			if assert.True(t, ok, "must implement Timeout and Temporary") {
+				assert.Equal(t, tt.wantTimeout, p.Timeout(), "Timeout")
+				assert.Equal(t, tt.wantTemporary, p.Temporary(), "Temporary")
+			}
+			assert.Equal(t, tt.wantTimeout, IsTimeout(err), "IsTimeout")
+
+			assert.Equal(t, tt.give.Error(), err.Error())
+			assert.Equal(t, fmt.Sprintf("%+v", tt.give), fmt.Sprintf("%+v", err))
+			assert.Equal(t, fmt.Sprintf("%#v", tt.give), fmt.Sprintf("%#v", err))
+			assert.Equal(t, Errors(tt.give), Errors(err))
+			for _, e := range Errors(tt.give) {
+				assert.ErrorIs(t, err, e)
+			}
+		})
+	}
+}